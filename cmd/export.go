@@ -0,0 +1,150 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pete-robinson/set-maker-grpc/internal/service"
+	"github.com/pete-robinson/set-maker-grpc/internal/service/harmony"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// columns of a CSV export. the first four match the import command so an export can be re-imported
+var exportColumns = []string{"artist", "title", "key", "tonality", "id", "artistId", "createdAt", "updatedAt", "version", "createdBy", "updatedBy"}
+
+
+// export a tenant's catalog to a CSV or JSON lines file
+// usage: export -tenant <id> [-format csv|jsonl] <file>
+// JSON lines hold one ExportCatalogItem per line and are the full backup. CSV holds one row per
+// song with its artist's name, so artists without songs are left out
+func runExport(ctx context.Context, svc *service.Service, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	format := flags.String("format", "", "file format, csv or jsonl. defaults to the file extension")
+	tenantId := flags.String("tenant", "", "tenant whose catalog is exported")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errors.New("usage: export -tenant <id> [-format csv|jsonl] <file>")
+	}
+
+	ctx, err := tenantContext(ctx, *tenantId)
+	if err != nil {
+		return err
+	}
+
+	path := flags.Arg(0)
+	if *format == "" {
+		*format = formatFromExtension(path)
+	}
+
+	var write func(*setmakerpb.ExportCatalogItem) error
+	var flush func() error
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	switch *format {
+	case FormatJSONL:
+		write, flush = jsonlWriter(f)
+	case FormatCSV:
+		write, flush, err = csvWriter(f)
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	count := 0
+	err = svc.ExportCatalog(ctx, func(item *setmakerpb.ExportCatalogItem) error {
+		count++
+		return write(item)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = flush(); err != nil {
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	fmt.Printf("exported %d records to %s\n", count, path)
+	return nil
+}
+
+
+// write each item as a line of JSON
+func jsonlWriter(f *os.File) (func(*setmakerpb.ExportCatalogItem) error, func() error) {
+	w := bufio.NewWriter(f)
+
+	write := func(item *setmakerpb.ExportCatalogItem) error {
+		line, err := protojson.Marshal(item)
+		if err != nil {
+			return err
+		}
+		if _, err = w.Write(line); err != nil {
+			return err
+		}
+		return w.WriteByte('\n')
+	}
+
+	return write, w.Flush
+}
+
+
+// write a row per song. artists arrive before songs, so their names are remembered for the song rows
+func csvWriter(f *os.File) (func(*setmakerpb.ExportCatalogItem) error, func() error, error) {
+	w := csv.NewWriter(f)
+	if err := w.Write(exportColumns); err != nil {
+		return nil, nil, err
+	}
+
+	artists := make(map[string]string)
+	write := func(item *setmakerpb.ExportCatalogItem) error {
+		if artist := item.GetArtist(); artist != nil {
+			artists[artist.Id] = artist.Name
+			return nil
+		}
+
+		song := item.GetSong()
+		if song == nil {
+			return nil
+		}
+
+		return w.Write([]string{
+			artists[song.ArtistId],
+			song.Title,
+			harmony.KeyName(song.Key),
+			strings.ToLower(strings.TrimPrefix(song.Tonality.String(), "TONALITY_")),
+			song.Id,
+			song.ArtistId,
+			song.GetMetadata().GetCreatedAt(),
+			song.GetMetadata().GetUpdatedAt(),
+			strconv.FormatInt(song.GetMetadata().GetVersion(), 10),
+			song.GetMetadata().GetCreatedBy(),
+			song.GetMetadata().GetUpdatedBy(),
+		})
+	}
+
+	flush := func() error {
+		w.Flush()
+		return w.Error()
+	}
+
+	return write, flush, nil
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pete-robinson/set-maker-grpc/internal/service"
+	"github.com/pete-robinson/set-maker-grpc/internal/service/harmony"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+)
+
+const (
+	FormatCSV   = "csv"
+	FormatJSONL = "jsonl"
+)
+
+// a row as it appears in an import file
+// keys and tonalities are read with harmony.ParseKey and harmony.ParseTonality, so "F#" and "minor" both work
+type importRecord struct {
+	Artist   string `json:"artist"`
+	Title    string `json:"title"`
+	Key      string `json:"key"`
+	Tonality string `json:"tonality"`
+}
+
+
+// import songs from a CSV or JSON lines file into a tenant's catalog
+// usage: import -tenant <id> [-format csv|jsonl] <file>
+// CSV files need a header row naming the artist, title, key and tonality columns.
+// Row numbers in the report count records, not lines, so the CSV header isn't counted
+func runImport(ctx context.Context, svc *service.Service, args []string) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	format := flags.String("format", "", "file format, csv or jsonl. defaults to the file extension")
+	tenantId := flags.String("tenant", "", "tenant whose catalog the songs are imported into")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errors.New("usage: import -tenant <id> [-format csv|jsonl] <file>")
+	}
+
+	ctx, err := tenantContext(ctx, *tenantId)
+	if err != nil {
+		return err
+	}
+
+	path := flags.Arg(0)
+	if *format == "" {
+		*format = formatFromExtension(path)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var records []importRecord
+	switch *format {
+	case FormatCSV:
+		records, err = readCSV(f)
+	case FormatJSONL:
+		records, err = readJSONL(f)
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+	if err != nil {
+		return err
+	}
+
+	// parse keys and tonalities. rows that don't parse are still sent so the report lines up
+	// with the file, but their parse error is reported in place of the service's
+	rows := make([]*setmakerpb.ImportCatalogRow, 0, len(records))
+	parseErrors := make(map[int32]string)
+	for i, record := range records {
+		row, err := record.row()
+		if err != nil {
+			parseErrors[int32(i+1)] = err.Error()
+		}
+		rows = append(rows, row)
+	}
+
+	report, err := svc.ImportCatalog(ctx, rows)
+	if err != nil {
+		return err
+	}
+
+	for _, result := range report.Results {
+		if result.Error == "" {
+			continue
+		}
+		if parseError, ok := parseErrors[result.Row]; ok {
+			fmt.Printf("row %d: %s\n", result.Row, parseError)
+		} else {
+			fmt.Printf("row %d: %s\n", result.Row, result.Error)
+		}
+	}
+	fmt.Printf("imported %d songs, %d failed, %d artists created\n", report.Imported, report.Failed, report.ArtistsCreated)
+
+	return nil
+}
+
+
+func formatFromExtension(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return FormatCSV
+	case ".jsonl", ".ndjson", ".json":
+		return FormatJSONL
+	}
+
+	return ""
+}
+
+
+// read CSV records, finding columns by their header
+func readCSV(r io.Reader) ([]importRecord, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("could not read CSV header: %w", err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"artist", "title", "key", "tonality"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("CSV header is missing the %s column", name)
+		}
+	}
+
+	var records []importRecord
+	for {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, importRecord{
+			Artist:   fields[columns["artist"]],
+			Title:    fields[columns["title"]],
+			Key:      fields[columns["key"]],
+			Tonality: fields[columns["tonality"]],
+		})
+	}
+}
+
+
+// read one JSON object per line. blank lines are skipped
+func readJSONL(r io.Reader) ([]importRecord, error) {
+	scanner := bufio.NewScanner(r)
+
+	var records []importRecord
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		var record importRecord
+		if err := json.Unmarshal([]byte(text), &record); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		records = append(records, record)
+	}
+
+	return records, scanner.Err()
+}
+
+
+// convert a record to an import row
+// a key or tonality that doesn't parse is left unset and returned as an error
+func (r importRecord) row() (*setmakerpb.ImportCatalogRow, error) {
+	row := &setmakerpb.ImportCatalogRow{
+		ArtistName: r.Artist,
+		Title:      r.Title,
+	}
+
+	key, keyErr := harmony.ParseKey(r.Key)
+	if keyErr == nil {
+		row.Key = key
+	}
+
+	tonality, tonalityErr := harmony.ParseTonality(r.Tonality)
+	if tonalityErr == nil {
+		row.Tonality = tonality
+	}
+
+	if keyErr != nil {
+		return row, keyErr
+	}
+	return row, tonalityErr
+}
@@ -2,16 +2,27 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
 	"os"
+	"time"
 
 	"github.com/joho/godotenv"
+	"github.com/pete-robinson/set-maker-grpc/internal/auth"
+	"github.com/pete-robinson/set-maker-grpc/internal/outbox"
+	"github.com/pete-robinson/set-maker-grpc/internal/purge"
 	repository "github.com/pete-robinson/set-maker-grpc/internal/repository/ddb"
+	"github.com/pete-robinson/set-maker-grpc/internal/repository/memory"
 	"github.com/pete-robinson/set-maker-grpc/internal/service"
+	"github.com/pete-robinson/set-maker-grpc/internal/tenant"
 	transport "github.com/pete-robinson/set-maker-grpc/internal/transport/grpc"
 	"github.com/pete-robinson/set-maker-grpc/internal/utils"
 	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
 	logger "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -20,8 +31,44 @@ const (
 	EnvAwsAccessSecret = "AWS_SECRET_ACCESS_KEY"
 	EnvAwsRegion       = "AWS_REGION"
 	EnvSnsTopic        = "EVENT_TOPIC"
+	EnvRepository      = "REPOSITORY"
+	EnvCursorSecret    = "CURSOR_SECRET"
+	// how long deleted artists and songs are kept before being purged, as a Go duration e.g. 720h
+	EnvTrashRetention = "TRASH_RETENTION"
+	// token verification. at least one of the JWKS file and HMAC secret must be set
+	EnvAuthJwksFile   = "AUTH_JWKS_FILE"
+	EnvAuthHmacSecret = "AUTH_HMAC_SECRET"
+	EnvAuthIssuer     = "AUTH_ISSUER"
+	EnvAuthAudience   = "AUTH_AUDIENCE"
+	// roles needed for each RPC. defaults to DefaultPolicyFile
+	EnvAuthPolicyFile = "AUTH_POLICY_FILE"
 )
 
+const DefaultPolicyFile = "config/policy.json"
+
+// set REPOSITORY=memory to run without AWS
+const RepositoryMemory = "memory"
+
+// recorded as the author of changes made by commands, which run without a token
+const CommandSubject = "command-line"
+
+// storage used by the service, the outbox relay and the trash purger
+type store interface {
+	service.Repository
+	outbox.Repository
+	purge.Repository
+}
+
+// storage holding data written before tenants were introduced
+type tenantAssigner interface {
+	AssignTenant(context.Context, string) (int, error)
+}
+
+// storage holding timestamps written before utils.TimestampLayout
+type timestampMigrator interface {
+	MigrateTimestamps(context.Context) (int, error)
+}
+
 func main() {
 	err := godotenv.Load()
 	if err != nil {
@@ -32,36 +79,75 @@ func main() {
 	// init context
 	ctx := context.Background()
 
-	// build AWS config obj
-	awsConfigObj := &utils.AwsConfig{
-		Region: os.Getenv(EnvAwsRegion),
+	// init pagination cursor signing
+	cursors, err := buildCursorCodec()
+	if err != nil {
+		panic(err)
 	}
 
-	awsConfig, err := utils.BuildAwsConfig(ctx, awsConfigObj)
+	retention, err := trashRetention()
 	if err != nil {
-		logger.Errorf("BOOT ERROR. COULD NOT BUILD AWS CONFIG: %s", err)
 		panic(err)
 	}
 
-	// init repository
-	dynamoClient := utils.CreateDynamoClient(awsConfig)
-	repo := repository.NewDynamoRepository(dynamoClient)
-
-	// sns service
-	snsClient := utils.CreateSnsClient(awsConfig)
-	t := service.SnsTopic(os.Getenv(EnvSnsTopic))
-	sns := service.NewSnsClient(snsClient, t)
+	// init repository and event publisher
+	var repo store
+	var publisher outbox.Publisher
+	if os.Getenv(EnvRepository) == RepositoryMemory {
+		logger.Warn("Using in-memory repository. Data will not be persisted")
+		repo = memory.NewMemoryRepository(cursors, retention)
+		publisher = service.NewLogPublisher()
+	} else {
+		repo, publisher, err = buildAwsClients(ctx, cursors, retention)
+		if err != nil {
+			panic(err)
+		}
+	}
 
 	// init Service
-	svc := service.NewService(repo, sns)
+	svc := service.NewService(repo)
+
+	// run a one-off command instead of the server if one was given
+	if len(os.Args) > 1 {
+		if err = runCommand(ctx, svc, repo, os.Args[1], os.Args[2:]); err != nil {
+			logger.Errorf("COMMAND FAILED: %s", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// start relaying outbox events
+	workerCtx, stopWorkers := context.WithCancel(ctx)
+	defer stopWorkers()
+	go outbox.NewRelay(repo, publisher).Run(workerCtx)
+
+	// start purging expired items from the trash
+	go purge.NewPurger(repo).Run(workerCtx)
+
+	// init request authentication and authorization
+	authenticator, err := buildAuthenticator()
+	if err != nil {
+		panic(err)
+	}
+	authorizer, err := buildAuthorizer()
+	if err != nil {
+		panic(err)
+	}
 
 	// init GRPC Server
 	server, err := transport.NewServer(svc)
 	if err != nil {
 		panic(err)
 	}
-	s := grpc.NewServer()
+	// every request is scoped to the tenant named in its metadata, and authorized by the caller's roles there
+	resolver := tenant.NewResolver(authorizer, auth.DefaultAllowList)
+
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(authenticator.UnaryInterceptor(), resolver.UnaryInterceptor()),
+		grpc.ChainStreamInterceptor(authenticator.StreamInterceptor(), resolver.StreamInterceptor()),
+	)
 	setmakerpb.RegisterSetMakerServiceServer(s, server)
+	healthpb.RegisterHealthServer(s, health.NewServer())
 	reflection.Register(s)
 
 	err = utils.RunGrpcServer(ctx, s)
@@ -70,3 +156,201 @@ func main() {
 	}
 
 }
+
+
+// run a command against the service
+func runCommand(ctx context.Context, svc *service.Service, repo store, name string, args []string) error {
+	ctx = auth.NewContext(ctx, &auth.Identity{Subject: CommandSubject})
+
+	switch name {
+	case "import":
+		return runImport(ctx, svc, args)
+	case "export":
+		return runExport(ctx, svc, args)
+	case "assign-tenant":
+		return runAssignTenant(ctx, repo, args)
+	case "migrate-timestamps":
+		return runMigrateTimestamps(ctx, repo, args)
+	}
+
+	return fmt.Errorf("unknown command %q", name)
+}
+
+
+// hand data written before tenants were introduced to a tenant
+// usage: assign-tenant <id>
+// Safe to run more than once. Only items with no tenant are touched
+func runAssignTenant(ctx context.Context, repo store, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: assign-tenant <id>")
+	}
+
+	assigner, ok := repo.(tenantAssigner)
+	if !ok {
+		return errors.New("the repository holds no data from before tenants")
+	}
+
+	assigned, err := assigner.AssignTenant(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	logger.WithFields(logger.Fields{
+		"tenant":   args[0],
+		"assigned": assigned,
+	}).Info("Items assigned to tenant")
+	return nil
+}
+
+
+// rewrite stored metadata timestamps in the current layout
+// usage: migrate-timestamps
+// Safe to run more than once, and while the server is running
+func runMigrateTimestamps(ctx context.Context, repo store, args []string) error {
+	if len(args) != 0 {
+		return errors.New("usage: migrate-timestamps")
+	}
+
+	migrator, ok := repo.(timestampMigrator)
+	if !ok {
+		return errors.New("the repository holds no timestamps to migrate")
+	}
+
+	migrated, err := migrator.MigrateTimestamps(ctx)
+	if err != nil {
+		return err
+	}
+
+	logger.WithField("migrated", migrated).Info("Timestamps migrated")
+	return nil
+}
+
+
+// copy of ctx acting for the tenant a command was given
+func tenantContext(ctx context.Context, id string) (context.Context, error) {
+	if id == "" {
+		return nil, errors.New("a -tenant is required")
+	}
+	if err := tenant.Validate(id); err != nil {
+		return nil, err
+	}
+
+	return tenant.NewContext(ctx, id), nil
+}
+
+
+// build the codec that signs pagination cursors
+// without a configured secret a random one is used, so cursors don't survive a restart
+// and aren't accepted by other instances
+func buildCursorCodec() (*utils.CursorCodec, error) {
+	secret := []byte(os.Getenv(EnvCursorSecret))
+	if len(secret) == 0 {
+		logger.Warnf("%s is not set. Using a random cursor secret", EnvCursorSecret)
+
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			logger.Errorf("BOOT ERROR. COULD NOT GENERATE CURSOR SECRET: %s", err)
+			return nil, err
+		}
+	}
+
+	return utils.NewCursorCodec(secret), nil
+}
+
+
+// build the authenticator that checks bearer tokens
+// HS256 tokens are verified with the HMAC secret or oct keys in the JWKS file, RS256 tokens with
+// the file's RSA keys. Refuses to start without any keys rather than serve unauthenticated
+func buildAuthenticator() (*auth.Authenticator, error) {
+	keys := auth.NewKeySet()
+	if path := os.Getenv(EnvAuthJwksFile); path != "" {
+		loaded, err := auth.LoadJWKS(path)
+		if err != nil {
+			logger.Errorf("BOOT ERROR. COULD NOT LOAD JWKS: %s", err)
+			return nil, err
+		}
+		keys = loaded
+	}
+
+	if secret := os.Getenv(EnvAuthHmacSecret); secret != "" {
+		keys.AddHMACSecret("", []byte(secret))
+	}
+
+	if keys.Empty() {
+		logger.Errorf("BOOT ERROR. NO TOKEN KEYS. SET %s OR %s", EnvAuthJwksFile, EnvAuthHmacSecret)
+		return nil, errors.New("no keys configured for token verification")
+	}
+
+	return auth.NewAuthenticator(auth.Config{
+		Keys:      keys,
+		Issuer:    os.Getenv(EnvAuthIssuer),
+		Audience:  os.Getenv(EnvAuthAudience),
+		AllowList: auth.DefaultAllowList,
+	}), nil
+}
+
+
+// build the authorizer from the policy file
+// every RPC of the service must have a policy, so a new RPC can't be served until it is given one
+func buildAuthorizer() (*auth.Authorizer, error) {
+	path := os.Getenv(EnvAuthPolicyFile)
+	if path == "" {
+		path = DefaultPolicyFile
+	}
+
+	policy, err := auth.LoadPolicy(path)
+	if err != nil {
+		logger.Errorf("BOOT ERROR. COULD NOT LOAD POLICY: %s", err)
+		return nil, err
+	}
+
+	if err = policy.Validate(setmakerpb.SetMakerService_ServiceDesc); err != nil {
+		logger.Errorf("BOOT ERROR. INVALID POLICY %s: %s", path, err)
+		return nil, err
+	}
+
+	return auth.NewAuthorizer(policy), nil
+}
+
+
+// how long deleted items are kept, defaulting to the repository's retention
+func trashRetention() (time.Duration, error) {
+	value := os.Getenv(EnvTrashRetention)
+	if value == "" {
+		return repository.DefaultTrashRetention, nil
+	}
+
+	retention, err := time.ParseDuration(value)
+	if err != nil || retention <= 0 {
+		logger.Errorf("BOOT ERROR. INVALID %s: %q", EnvTrashRetention, value)
+		return 0, fmt.Errorf("invalid %s %q", EnvTrashRetention, value)
+	}
+
+	return retention, nil
+}
+
+
+// build the DynamoDB repository and SNS publisher
+func buildAwsClients(ctx context.Context, cursors *utils.CursorCodec, retention time.Duration) (*repository.DynamoRepository, *service.SnsClient, error) {
+	// build AWS config obj
+	awsConfigObj := &utils.AwsConfig{
+		Region: os.Getenv(EnvAwsRegion),
+	}
+
+	awsConfig, err := utils.BuildAwsConfig(ctx, awsConfigObj)
+	if err != nil {
+		logger.Errorf("BOOT ERROR. COULD NOT BUILD AWS CONFIG: %s", err)
+		return nil, nil, err
+	}
+
+	// init repository
+	dynamoClient := utils.CreateDynamoClient(awsConfig)
+	repo := repository.NewDynamoRepository(dynamoClient, cursors, retention)
+
+	// sns service
+	snsClient := utils.CreateSnsClient(awsConfig)
+	t := service.SnsTopic(os.Getenv(EnvSnsTopic))
+	sns := service.NewSnsClient(snsClient, t)
+
+	return repo, sns, nil
+}
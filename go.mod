@@ -4,10 +4,13 @@ go 1.18
 
 require (
 	github.com/aws/aws-sdk-go-v2/config v1.17.1
+	github.com/golang-jwt/jwt/v4 v4.5.2
 	github.com/google/uuid v1.1.2
 	github.com/joho/godotenv v1.4.0
 	github.com/pete-robinson/setmaker-proto v1.0.4
 	github.com/sirupsen/logrus v1.9.0
+	golang.org/x/text v0.4.0
+	google.golang.org/genproto v0.0.0-20200526211855-cb27e3aa2013
 	google.golang.org/grpc v1.50.1
 	google.golang.org/protobuf v1.28.1
 )
@@ -38,6 +41,6 @@ require (
 	github.com/golang/protobuf v1.5.2 // indirect
 	golang.org/x/net v0.1.0 // indirect
 	golang.org/x/sys v0.1.0 // indirect
-	golang.org/x/text v0.4.0 // indirect
-	google.golang.org/genproto v0.0.0-20200526211855-cb27e3aa2013 // indirect
 )
+
+replace github.com/pete-robinson/setmaker-proto => ./proto
@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// The authenticated caller of a request, taken from its bearer token
+type Identity struct {
+	// the token's sub claim
+	Subject string
+	// the token's tenants claim, mapping each tenant the caller can act for to its roles there:
+	// {"acme": ["editor"], "globex": "viewer"}. empty if the token has none
+	Tenants map[string][]string
+	// every claim in the token
+	Claims jwt.MapClaims
+}
+
+type identityKey struct{}
+
+
+// copy of ctx carrying the caller's identity
+func NewContext(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+
+// the identity of the caller, if the request was authenticated
+// requests to allow-listed methods carry no identity
+func FromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(*Identity)
+	return identity, ok
+}
+
+
+// the highest of the caller's roles in a tenant. roles the server doesn't know are ignored,
+// and a caller has no role in a tenant it isn't a member of
+func (i *Identity) RoleIn(tenant string) Role {
+	highest := RoleNone
+	for _, name := range i.Tenants[tenant] {
+		if role, err := ParseRole(name); err == nil && role > highest {
+			highest = role
+		}
+	}
+
+	return highest
+}
+
+
+// whether the caller can act for a tenant
+func (i *Identity) HasTenant(id string) bool {
+	_, ok := i.Tenants[id]
+	return ok
+}
+
+
+// build an identity from verified claims
+func identityFromClaims(claims jwt.MapClaims) *Identity {
+	identity := &Identity{Claims: claims}
+	identity.Subject, _ = claims["sub"].(string)
+
+	identity.Tenants = tenantsClaim(claims["tenants"])
+
+	return identity
+}
+
+
+// a claim mapping tenant ids to the caller's roles in each
+func tenantsClaim(claim interface{}) map[string][]string {
+	tenants := make(map[string][]string)
+
+	if claim, ok := claim.(map[string]interface{}); ok {
+		for tenant, roles := range claim {
+			tenants[tenant] = stringsClaim(roles)
+		}
+	}
+
+	return tenants
+}
+
+
+// a claim holding either a single string or a list of them
+func stringsClaim(claim interface{}) []string {
+	var values []string
+
+	switch claim := claim.(type) {
+	case string:
+		values = []string{claim}
+	case []interface{}:
+		for _, value := range claim {
+			if v, ok := value.(string); ok {
+				values = append(values, v)
+			}
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,158 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Methods that can be called without a token: server reflection and health checks
+// entries ending in "/" allow every method of a service
+var DefaultAllowList = []string{
+	"/grpc.reflection.v1alpha.ServerReflection/",
+	"/grpc.reflection.v1.ServerReflection/",
+	"/grpc.health.v1.Health/",
+}
+
+type Config struct {
+	Keys *KeySet
+	// required iss claim. not checked if empty
+	Issuer string
+	// required aud claim. not checked if empty
+	Audience string
+	// full method names, or service prefixes ending in "/", that don't need a token
+	AllowList []string
+}
+
+// Checks the bearer token of each request and puts the caller's identity into its context
+// Requests without a valid token fail with Unauthenticated. Tokens must carry an exp claim
+type Authenticator struct {
+	keys      *KeySet
+	issuer    string
+	audience  string
+	allowList []string
+	parser    *jwt.Parser
+}
+
+
+func NewAuthenticator(config Config) *Authenticator {
+	return &Authenticator{
+		keys:      config.Keys,
+		issuer:    config.Issuer,
+		audience:  config.Audience,
+		allowList: config.AllowList,
+		parser: jwt.NewParser(jwt.WithValidMethods([]string{
+			jwt.SigningMethodHS256.Alg(),
+			jwt.SigningMethodRS256.Alg(),
+		})),
+	}
+}
+
+
+func (a *Authenticator) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+
+func (a *Authenticator) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authenticate(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &authenticatedStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+
+// verify the request's token, returning a context carrying the caller's identity
+func (a *Authenticator) authenticate(ctx context.Context, method string) (context.Context, error) {
+	if Allowed(a.allowList, method) {
+		return ctx, nil
+	}
+
+	raw, err := bearerToken(ctx)
+	if err != nil {
+		logger.WithField("method", method).Warn("Auth: Missing bearer token")
+		return nil, err
+	}
+
+	claims := jwt.MapClaims{}
+	if _, err = a.parser.ParseWithClaims(raw, claims, a.keys.keyFunc); err != nil {
+		logger.WithField("method", method).Warnf("Auth: Invalid token: %s", err)
+		return nil, status.Error(codes.Unauthenticated, "Invalid token")
+	}
+
+	// the parser only checks the time claims a token has, but a token must say when it expires
+	now := jwt.TimeFunc().Unix()
+	if !claims.VerifyExpiresAt(now, true) {
+		logger.WithField("method", method).Warn("Auth: Token has no expiry or has expired")
+		return nil, status.Error(codes.Unauthenticated, "Invalid token")
+	}
+	if !claims.VerifyNotBefore(now, false) {
+		logger.WithField("method", method).Warn("Auth: Token is not valid yet")
+		return nil, status.Error(codes.Unauthenticated, "Invalid token")
+	}
+
+	if a.issuer != "" && !claims.VerifyIssuer(a.issuer, true) {
+		logger.WithField("method", method).Warn("Auth: Token has the wrong issuer")
+		return nil, status.Error(codes.Unauthenticated, "Invalid token")
+	}
+	if a.audience != "" && !claims.VerifyAudience(a.audience, true) {
+		logger.WithField("method", method).Warn("Auth: Token has the wrong audience")
+		return nil, status.Error(codes.Unauthenticated, "Invalid token")
+	}
+
+	return NewContext(ctx, identityFromClaims(claims)), nil
+}
+
+
+// whether a method is on an allow-list
+func Allowed(allowList []string, method string) bool {
+	for _, entry := range allowList {
+		if entry == method || (strings.HasSuffix(entry, "/") && strings.HasPrefix(method, entry)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+
+// the token from the request's authorization header
+func bearerToken(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		scheme, token, ok := strings.Cut(value, " ")
+		if ok && strings.EqualFold(scheme, "bearer") && strings.TrimSpace(token) != "" {
+			return strings.TrimSpace(token), nil
+		}
+	}
+
+	return "", status.Error(codes.Unauthenticated, "Missing bearer token")
+}
+
+// a server stream with the authenticated context
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"context"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestMain(m *testing.M) {
+	logger.SetOutput(io.Discard)
+	os.Exit(m.Run())
+}
+
+
+func TestAuthenticateChecksTokenTimes(t *testing.T) {
+	secret := []byte("test secret")
+	keys := NewKeySet()
+	keys.AddHMACSecret("", secret)
+	authenticator := NewAuthenticator(Config{Keys: keys})
+
+	now := time.Now()
+	tests := []struct {
+		name   string
+		claims jwt.MapClaims
+		want   codes.Code
+	}{
+		{"valid", jwt.MapClaims{"sub": "alice", "exp": now.Add(time.Hour).Unix()}, codes.OK},
+		{"valid with not before", jwt.MapClaims{"sub": "alice", "exp": now.Add(time.Hour).Unix(), "nbf": now.Add(-time.Minute).Unix()}, codes.OK},
+		{"no expiry", jwt.MapClaims{"sub": "alice"}, codes.Unauthenticated},
+		{"expired", jwt.MapClaims{"sub": "alice", "exp": now.Add(-time.Minute).Unix()}, codes.Unauthenticated},
+		{"not valid yet", jwt.MapClaims{"sub": "alice", "exp": now.Add(time.Hour).Unix(), "nbf": now.Add(time.Hour).Unix()}, codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, tt.claims).SignedString(secret)
+			if err != nil {
+				t.Fatalf("could not sign token: %s", err)
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+
+			ctx, err = authenticator.authenticate(ctx, "/api.SetMakerService/GetArtist")
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("got code %s (%v), want %s", got, err, tt.want)
+			}
+			if err != nil {
+				return
+			}
+
+			identity, ok := FromContext(ctx)
+			if !ok || identity.Subject != "alice" {
+				t.Errorf("identity = %v, want subject alice", identity)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// Keys used to verify token signatures
+// HS256 tokens are checked against the HMAC secrets and RS256 tokens against the RSA public keys.
+// Keys are looked up by the token's kid header; a token without a kid is accepted when there is
+// exactly one key for its algorithm
+type KeySet struct {
+	hmac map[string][]byte
+	rsa  map[string]*rsa.PublicKey
+}
+
+// a JSON web key set, as described in RFC 7517. only RSA and oct keys are read
+type jwks struct {
+	Keys []jwk `json:"keys"`
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	// RSA modulus and exponent
+	N string `json:"n"`
+	E string `json:"e"`
+	// symmetric key value
+	K string `json:"k"`
+}
+
+
+func NewKeySet() *KeySet {
+	return &KeySet{
+		hmac: make(map[string][]byte),
+		rsa:  make(map[string]*rsa.PublicKey),
+	}
+}
+
+
+// Load the keys of a JWKS file
+func LoadJWKS(path string) (*KeySet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var set jwks
+	if err = json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("could not parse JWKS %s: %w", path, err)
+	}
+
+	keys := NewKeySet()
+	for i, key := range set.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+
+		switch key.Kty {
+		case "RSA":
+			pub, err := rsaPublicKey(key)
+			if err != nil {
+				return nil, fmt.Errorf("JWKS key %d: %w", i, err)
+			}
+			keys.rsa[key.Kid] = pub
+		case "oct":
+			secret, err := base64.RawURLEncoding.DecodeString(key.K)
+			if err != nil || len(secret) == 0 {
+				return nil, fmt.Errorf("JWKS key %d: invalid k", i)
+			}
+			keys.hmac[key.Kid] = secret
+		}
+	}
+
+	return keys, nil
+}
+
+
+// Add an HMAC secret for HS256 tokens with the given kid, or with no kid if it is empty
+func (k *KeySet) AddHMACSecret(kid string, secret []byte) {
+	k.hmac[kid] = secret
+}
+
+
+// whether there are any keys to verify tokens with
+func (k *KeySet) Empty() bool {
+	return len(k.hmac) == 0 && len(k.rsa) == 0
+}
+
+
+// pick the key that verifies a token, based on its algorithm and kid
+func (k *KeySet) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	switch token.Method.Alg() {
+	case jwt.SigningMethodHS256.Alg():
+		return lookupKey(k.hmac, kid)
+	case jwt.SigningMethodRS256.Alg():
+		return lookupKey(k.rsa, kid)
+	}
+
+	return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+}
+
+
+func lookupKey[T any](keys map[string]T, kid string) (interface{}, error) {
+	if key, ok := keys[kid]; ok {
+		return key, nil
+	}
+
+	if kid == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key, nil
+		}
+	}
+
+	return nil, errors.New("no key for token")
+}
+
+
+func rsaPublicKey(key jwk) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(key.N)
+	if err != nil || len(n) == 0 {
+		return nil, errors.New("invalid modulus")
+	}
+
+	e, err := base64.RawURLEncoding.DecodeString(key.E)
+	if err != nil || len(e) == 0 {
+		return nil, errors.New("invalid exponent")
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(new(big.Int).SetBytes(e).Int64()),
+	}, nil
+}
@@ -0,0 +1,156 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Roles a caller can hold, each granting everything the ones before it do
+type Role int
+
+const (
+	RoleNone Role = iota
+	RoleViewer
+	RoleEditor
+	RoleAdmin
+)
+
+var roleNames = map[string]Role{
+	"viewer": RoleViewer,
+	"editor": RoleEditor,
+	"admin":  RoleAdmin,
+}
+
+// The role needed to call each method, keyed by full method name
+// Loaded from a JSON file mapping service names to their methods' roles:
+// {"api.SetMakerService": {"GetArtist": "viewer", "DeleteArtist": "admin"}}
+type Policy struct {
+	methods map[string]Role
+}
+
+
+func ParseRole(name string) (Role, error) {
+	role, ok := roleNames[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return RoleNone, fmt.Errorf("unknown role %q", name)
+	}
+
+	return role, nil
+}
+
+
+func (r Role) String() string {
+	for name, role := range roleNames {
+		if role == r {
+			return name
+		}
+	}
+
+	return "none"
+}
+
+
+// Load a policy file
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var services map[string]map[string]string
+	if err = json.Unmarshal(data, &services); err != nil {
+		return nil, fmt.Errorf("could not parse policy %s: %w", path, err)
+	}
+
+	policy := &Policy{methods: make(map[string]Role)}
+	for service, methods := range services {
+		for method, name := range methods {
+			role, err := ParseRole(name)
+			if err != nil {
+				return nil, fmt.Errorf("policy for %s/%s: %w", service, method, err)
+			}
+			policy.methods[fmt.Sprintf("/%s/%s", service, method)] = role
+		}
+	}
+
+	return policy, nil
+}
+
+
+// Check the policy covers every method of a service, and names no methods the service doesn't have
+func (p *Policy) Validate(desc grpc.ServiceDesc) error {
+	known := make(map[string]bool)
+	for _, method := range desc.Methods {
+		known[fmt.Sprintf("/%s/%s", desc.ServiceName, method.MethodName)] = true
+	}
+	for _, stream := range desc.Streams {
+		known[fmt.Sprintf("/%s/%s", desc.ServiceName, stream.StreamName)] = true
+	}
+
+	var missing, unknown []string
+	for method := range known {
+		if _, ok := p.methods[method]; !ok {
+			missing = append(missing, method)
+		}
+	}
+	for method := range p.methods {
+		if strings.HasPrefix(method, "/"+desc.ServiceName+"/") && !known[method] {
+			unknown = append(unknown, method)
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("no policy for %s", strings.Join(missing, ", "))
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("policy names unknown methods %s", strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
+
+// Checks the caller's roles against the policy for each request
+// Roles are granted per tenant, so the tenant Resolver calls this once it knows the tenant a
+// request acts for. Methods without a policy are denied
+type Authorizer struct {
+	policy *Policy
+}
+
+
+func NewAuthorizer(policy *Policy) *Authorizer {
+	return &Authorizer{
+		policy: policy,
+	}
+}
+
+
+// Check the caller's role in a tenant allows it to call a method
+func (a *Authorizer) Authorize(identity *Identity, tenant string, method string) error {
+	required, ok := a.policy.methods[method]
+	if !ok {
+		logger.WithField("method", method).Error("Auth: No policy for method")
+		return status.Error(codes.PermissionDenied, "Method is not permitted")
+	}
+
+	if identity.RoleIn(tenant) < required {
+		logger.WithFields(logger.Fields{
+			"method":  method,
+			"subject": identity.Subject,
+			"tenant":  tenant,
+			"roles":   identity.Tenants[tenant],
+		}).Warn("Auth: Permission denied")
+		return status.Errorf(codes.PermissionDenied, "This method needs the %s role in the tenant", required)
+	}
+
+	return nil
+}
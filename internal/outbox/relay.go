@@ -0,0 +1,168 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	repository "github.com/pete-robinson/set-maker-grpc/internal/repository/ddb"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	// how often the outbox is polled for pending entries
+	DefaultPollInterval = 2 * time.Second
+	// entries fetched per poll
+	DefaultBatchSize int32 = 25
+	// failed attempts before an entry is marked failed and left for investigation
+	DefaultMaxAttempts int32 = 10
+	// delay before the first retry. doubles with every further attempt
+	DefaultBaseBackoff = time.Second
+	// longest delay between retries
+	DefaultMaxBackoff = 5 * time.Minute
+)
+
+type Repository interface {
+	ListPendingOutbox(context.Context, int32) ([]*repository.OutboxEntry, error)
+	MarkOutboxSent(context.Context, string) error
+	RescheduleOutbox(context.Context, *repository.OutboxEntry) error
+}
+
+type Publisher interface {
+	Publish(context.Context, *setmakerpb.Event) (*string, error)
+}
+
+// Publishes events written to the outbox and marks them sent
+// Delivery is at least once: an entry published but not marked sent will be published again
+type Relay struct {
+	repository   Repository
+	publisher    Publisher
+	pollInterval time.Duration
+	batchSize    int32
+	maxAttempts  int32
+	baseBackoff  time.Duration
+	maxBackoff   time.Duration
+}
+
+
+func NewRelay(repo Repository, publisher Publisher) *Relay {
+	return &Relay{
+		repository:   repo,
+		publisher:    publisher,
+		pollInterval: DefaultPollInterval,
+		batchSize:    DefaultBatchSize,
+		maxAttempts:  DefaultMaxAttempts,
+		baseBackoff:  DefaultBaseBackoff,
+		maxBackoff:   DefaultMaxBackoff,
+	}
+}
+
+
+// Poll the outbox until the context is cancelled
+func (r *Relay) Run(ctx context.Context) {
+	logger.WithField("interval", r.pollInterval).Info("Outbox relay started")
+
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		// keep draining while full batches are published
+		for r.relayBatch(ctx) == int(r.batchSize) {
+			if ctx.Err() != nil {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info("Outbox relay stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+
+// publish one batch of pending entries, returning how many were published
+func (r *Relay) relayBatch(ctx context.Context) int {
+	entries, err := r.repository.ListPendingOutbox(ctx, r.batchSize)
+	if err != nil {
+		logger.Errorf("Outbox relay: Could not list pending entries: %s", err)
+		return 0
+	}
+
+	published := 0
+	for _, entry := range entries {
+		if ctx.Err() != nil {
+			break
+		}
+		if r.relay(ctx, entry) {
+			published++
+		}
+	}
+
+	return published
+}
+
+
+// publish an entry, reporting whether it was published and marked sent
+func (r *Relay) relay(ctx context.Context, entry *repository.OutboxEntry) bool {
+	event, err := entry.Event()
+	if err != nil {
+		// a payload that can't be decoded will never succeed, so don't retry it
+		logger.WithField("id", entry.Id).Errorf("Outbox relay: Could not decode event: %s", err)
+		entry.Attempts = r.maxAttempts - 1
+		r.fail(ctx, entry, err)
+		return false
+	}
+
+	if _, err = r.publisher.Publish(ctx, event); err != nil {
+		logger.WithFields(logger.Fields{
+			"id":       entry.Id,
+			"attempts": entry.Attempts + 1,
+		}).Errorf("Outbox relay: Could not publish event: %s", err)
+		r.fail(ctx, entry, err)
+		return false
+	}
+
+	if err = r.repository.MarkOutboxSent(ctx, entry.Id); err != nil {
+		logger.WithField("id", entry.Id).Errorf("Outbox relay: Event published but not marked sent: %s", err)
+		return false
+	}
+
+	logger.WithFields(logger.Fields{
+		"id":        entry.Id,
+		"eventType": entry.EventType,
+	}).Info("Outbox relay: Event published")
+	return true
+}
+
+
+// record a failed attempt and schedule a retry with exponential backoff
+func (r *Relay) fail(ctx context.Context, entry *repository.OutboxEntry, cause error) {
+	entry.Attempts++
+	entry.LastError = cause.Error()
+
+	if entry.Attempts >= r.maxAttempts {
+		entry.Status = repository.OutboxFailed
+		logger.WithField("id", entry.Id).Error("Outbox relay: Giving up on event")
+	} else {
+		entry.NextAttemptAt = time.Now().Add(r.backoff(entry.Attempts)).UnixMilli()
+	}
+
+	if err := r.repository.RescheduleOutbox(ctx, entry); err != nil {
+		logger.WithField("id", entry.Id).Errorf("Outbox relay: Could not reschedule entry: %s", err)
+	}
+}
+
+
+func (r *Relay) backoff(attempts int32) time.Duration {
+	delay := r.baseBackoff
+	for i := int32(1); i < attempts; i++ {
+		delay *= 2
+		if delay >= r.maxBackoff {
+			return r.maxBackoff
+		}
+	}
+	return delay
+}
@@ -0,0 +1,87 @@
+package purge
+
+import (
+	"context"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	// how often the trash is checked for expired items
+	DefaultInterval = time.Hour
+	// items removed per table per purge call
+	DefaultBatchSize int32 = 25
+)
+
+type Repository interface {
+	PurgeTrash(context.Context, time.Time, int32) (int, error)
+}
+
+// Hard deletes artists and songs whose time in the trash has run out
+// The retention itself is applied by the repository when an item is deleted, and DynamoDB's TTL
+// removes expired items eventually regardless. This makes the purge prompt and covers the memory repository
+type Purger struct {
+	repository Repository
+	interval   time.Duration
+	batchSize  int32
+	clock      func() time.Time
+}
+
+
+func NewPurger(repo Repository) *Purger {
+	return &Purger{
+		repository: repo,
+		interval:   DefaultInterval,
+		batchSize:  DefaultBatchSize,
+		clock:      time.Now,
+	}
+}
+
+
+// Replace the clock that decides which items have expired, so tests can control it
+func (p *Purger) SetClock(clock func() time.Time) {
+	p.clock = clock
+}
+
+
+// Purge expired items until the context is cancelled
+func (p *Purger) Run(ctx context.Context) {
+	logger.WithField("interval", p.interval).Info("Trash purger started")
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			logger.Info("Trash purger stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+
+// remove everything that has expired, a batch at a time
+func (p *Purger) purge(ctx context.Context) {
+	total := 0
+	for ctx.Err() == nil {
+		purged, err := p.repository.PurgeTrash(ctx, p.clock(), p.batchSize)
+		if err != nil {
+			logger.Errorf("Trash purger: Could not purge expired items: %s", err)
+			break
+		}
+
+		total += purged
+		if purged == 0 {
+			break
+		}
+	}
+
+	if total > 0 {
+		logger.WithField("purged", total).Info("Trash purger: Expired items removed")
+	}
+}
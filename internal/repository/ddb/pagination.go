@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/pete-robinson/set-maker-grpc/internal/utils"
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// max number of Scan or Query calls made to fill one page
+	// stops a selective filter reading the whole table in a single request
+	maxPageRequests = 10
+	// how long a tenant's item count for a table is used before it is counted again
+	itemCountTTL = 5 * time.Minute
+	// how long one count may scan for
+	itemCountTimeout = time.Minute
+)
+
+// a single Scan or Query call starting at startKey and evaluating at most limit items
+type pageFetcher func(ctx context.Context, startKey map[string]types.AttributeValue, limit int32) (*fetchedPage, error)
+
+type fetchedPage struct {
+	items            []map[string]types.AttributeValue
+	lastEvaluatedKey map[string]types.AttributeValue
+}
+
+// live item counts per tenant and table, counted in the background by scanning
+type itemCounts struct {
+	mu     sync.Mutex
+	counts map[string]itemCount
+}
+
+type itemCount struct {
+	count     int64
+	fetchedAt time.Time
+	// a count is in flight
+	refreshing bool
+}
+
+
+// fetch pages from dynamo until limit items have been collected or there is nothing left to read
+// Scan and Query apply Limit before filtering, so a single call can come back short even when more items match.
+// Cursors are bound to the shape of the query that issued them. The returned cursor is empty once
+// the table or index is exhausted
+func (d *DynamoRepository) fillPage(ctx context.Context, shape utils.QueryShape, limit int32, cursor string, fetch pageFetcher) ([]map[string]types.AttributeValue, string, error) {
+	// decode the cursor
+	startKey, err := d.cursors.Decode(shape, cursor)
+	if errors.Is(err, utils.ErrCursorMismatch) {
+		logger.WithField("cursor", cursor).Warn("fillPage Repo: Cursor reused against a different query")
+		return nil, "", status.Error(codes.InvalidArgument, "Cursor does not belong to this query")
+	}
+	if err != nil {
+		logger.WithField("cursor", cursor).Warnf("fillPage Repo: Could not decode cursor: %s", err)
+		return nil, "", status.Error(codes.InvalidArgument, "Invalid cursor")
+	}
+
+	if limit < 1 {
+		return nil, "", status.Error(codes.InvalidArgument, "Invalid page size")
+	}
+
+	var items []map[string]types.AttributeValue
+	for requests := 0; requests < maxPageRequests; requests++ {
+		page, err := fetch(ctx, startKey, limit-int32(len(items)))
+		if err != nil {
+			logger.WithField("cursor", cursor).Errorf("fillPage Repo: Error response from dynamo: %s", err)
+			return nil, "", status.Error(codes.Internal, "Error fetching results")
+		}
+
+		items = append(items, page.items...)
+		startKey = page.lastEvaluatedKey
+
+		if startKey == nil || int32(len(items)) >= limit {
+			break
+		}
+	}
+
+	// encode the return cursor
+	returnCursor, err := d.cursors.Encode(shape, startKey)
+	if err != nil {
+		logger.WithField("lastEvaluatedKey", startKey).Errorf("fillPage Repo: Unable to encode attribute map: %s", err)
+		return nil, "", status.Error(codes.Internal, "Could not encode cursor")
+	}
+
+	return items, returnCursor, nil
+}
+
+
+// approximate number of a tenant's live items in a table
+// the count takes a scan, so it is never made on the request path: the last count is returned,
+// or 0 until there is one, and a stale or missing count is refreshed in the background
+func (d *DynamoRepository) itemCount(table string, tenantId string) int64 {
+	key := tenantKey(tenantId, table)
+
+	d.counts.mu.Lock()
+	defer d.counts.mu.Unlock()
+
+	if d.counts.counts == nil {
+		d.counts.counts = make(map[string]itemCount)
+	}
+
+	cached := d.counts.counts[key]
+	if !cached.refreshing && time.Since(cached.fetchedAt) >= itemCountTTL {
+		cached.refreshing = true
+		d.counts.counts[key] = cached
+		go d.refreshItemCount(table, tenantId, key)
+	}
+
+	return cached.count
+}
+
+
+// count a tenant's live items in a table and cache the count
+// runs apart from any request, so it isn't cut short when the request that started it ends.
+// on failure the previous count is kept and the next listing tries again
+func (d *DynamoRepository) refreshItemCount(table string, tenantId string, key string) {
+	ctx, cancel := context.WithTimeout(context.Background(), itemCountTimeout)
+	defer cancel()
+
+	var count int64
+	var startKey map[string]types.AttributeValue
+	for {
+		res, err := d.client.Scan(ctx, &dynamodb.ScanInput{
+			TableName: aws.String(table),
+			Select: types.SelectCount,
+			FilterExpression: aws.String("#tenant = :tenant AND attribute_not_exists(#trash)"),
+			ExpressionAttributeNames: map[string]string{
+				"#tenant": TenantAttribute,
+				"#trash": "Trash",
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":tenant": &types.AttributeValueMemberS{Value: tenantId},
+			},
+			ExclusiveStartKey: startKey,
+		})
+		if err != nil {
+			logger.WithField("table", table).Warnf("itemCount Repo: Could not count items: %s", err)
+
+			d.counts.mu.Lock()
+			cached := d.counts.counts[key]
+			cached.refreshing = false
+			d.counts.counts[key] = cached
+			d.counts.mu.Unlock()
+			return
+		}
+
+		count += int64(res.Count)
+		startKey = res.LastEvaluatedKey
+		if startKey == nil {
+			break
+		}
+	}
+
+	d.counts.mu.Lock()
+	d.counts.counts[key] = itemCount{count: count, fetchedAt: time.Now()}
+	d.counts.mu.Unlock()
+}
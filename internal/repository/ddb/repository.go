@@ -1,21 +1,204 @@
 package repository
 
 import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/google/uuid"
+	"github.com/pete-robinson/set-maker-grpc/internal/utils"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	ArtistsTable  = "artists"
+	SongsTable    = "songs"
+	SetlistsTable = "setlists"
+	OutboxTable   = "outbox"
+	// guard items reserving normalised artist names
+	ArtistNamesTable = "artist_names"
 )
 
 const (
-	ArtistsTable = "artists"
-	SongsTable   = "songs"
+	// max number of items DynamoDB accepts in a single TransactWriteItems call
+	TransactWriteLimit = 25
+	// number of new songs PutSongs writes in one transaction, each alongside its event
+	SongsPerTransaction = TransactWriteLimit / 2
+	// max number of keys DynamoDB accepts in a single BatchGetItem call
+	BatchGetLimit = 100
+	// number of times unprocessed keys are resubmitted before giving up
+	batchMaxAttempts = 5
+	// initial delay before resubmitting unprocessed keys. doubles on each attempt
+	batchBaseDelay = 50 * time.Millisecond
 )
 
 type DynamoRepository struct {
 	client *dynamodb.Client
+	cursors *utils.CursorCodec
+	counts itemCounts
+	// how long deleted artists and songs are kept before they are purged
+	retention time.Duration
 }
 
 
-func NewDynamoRepository(client *dynamodb.Client) *DynamoRepository {
+func NewDynamoRepository(client *dynamodb.Client, cursors *utils.CursorCodec, retention time.Duration) *DynamoRepository {
 	return &DynamoRepository{
 		client: client,
+		cursors: cursors,
+		retention: retention,
+	}
+}
+
+
+// read items from a table by Id in chunks of BatchGetLimit
+// unprocessed keys are resubmitted with exponential backoff. ids must be unique and
+// items come back in no particular order; ids with no item are simply absent
+func (d *DynamoRepository) batchGet(ctx context.Context, table string, ids []string) ([]map[string]types.AttributeValue, error) {
+	var items []map[string]types.AttributeValue
+
+	for start := 0; start < len(ids); start += BatchGetLimit {
+		end := start + BatchGetLimit
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		keys := make([]map[string]types.AttributeValue, 0, end-start)
+		for _, id := range ids[start:end] {
+			keys = append(keys, map[string]types.AttributeValue{
+				"Id": &types.AttributeValueMemberS{Value: id},
+			})
+		}
+
+		pending := map[string]types.KeysAndAttributes{
+			table: {Keys: keys},
+		}
+
+		delay := batchBaseDelay
+		for attempt := 1; len(pending) > 0; attempt++ {
+			if attempt > batchMaxAttempts {
+				logger.WithFields(logger.Fields{
+					"table": table,
+					"unprocessed": len(pending[table].Keys),
+				}).Error("batchGet Repo: Giving up on unprocessed keys")
+				return nil, errors.New("unprocessed keys remain after retries")
+			}
+
+			res, err := d.client.BatchGetItem(ctx, &dynamodb.BatchGetItemInput{
+				RequestItems: pending,
+			})
+			if err != nil {
+				logger.WithField("table", table).Errorf("batchGet Repo: Error response from dynamo: %s", err)
+				return nil, err
+			}
+
+			items = append(items, res.Responses[table]...)
+
+			pending = res.UnprocessedKeys
+			if len(pending) == 0 {
+				break
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(delay):
+			}
+			delay *= 2
+		}
+	}
+
+	return items, nil
+}
+
+
+// ids as strings with duplicates removed. BatchGetItem rejects requests with repeated keys
+func uniqueIds(ids []uuid.UUID) []string {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	res := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			res = append(res, id.String())
+		}
+	}
+
+	return res
+}
+
+
+// write changes together with the outbox entries for the events they raised
+// either everything is written or nothing is. changes keep their index in the transaction
+func (d *DynamoRepository) transactWithEvents(ctx context.Context, tenantId string, changes []types.TransactWriteItem, events []*setmakerpb.Event) error {
+	puts, err := outboxPuts(events, tenantId)
+	if err != nil {
+		return err
+	}
+
+	return d.transactWrite(ctx, append(changes, puts...))
+}
+
+
+func (d *DynamoRepository) transactWrite(ctx context.Context, items []types.TransactWriteItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	_, err := d.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: items,
+	})
+	return err
+}
+
+
+// condition that the stored item is still at the version before this write
+// items written before versioning was introduced have no version and are treated as version 0
+func versionCondition(version int64) (*string, map[string]string, map[string]types.AttributeValue) {
+	names := map[string]string{
+		"#meta": "Metadata",
+		"#version": "Version",
+	}
+
+	expected := version - 1
+	if expected <= 0 {
+		return aws.String("attribute_not_exists(#meta.#version) OR #meta.#version = :zero"), names, map[string]types.AttributeValue{
+			":zero": &types.AttributeValueMemberN{Value: "0"},
+		}
 	}
+
+	return aws.String("#meta.#version = :expected"), names, map[string]types.AttributeValue{
+		":expected": &types.AttributeValueMemberN{Value: strconv.FormatInt(expected, 10)},
+	}
+}
+
+
+// whether a write failed because an item's condition check failed
+func isConditionFailure(err error) bool {
+	var cancelled *types.TransactionCanceledException
+	if errors.As(err, &cancelled) {
+		for _, reason := range cancelled.CancellationReasons {
+			if reason.Code != nil && *reason.Code == "ConditionalCheckFailed" {
+				return true
+			}
+		}
+	}
+
+	var failed *types.ConditionalCheckFailedException
+	return errors.As(err, &failed)
+}
+
+
+// whether the transaction item at index caused a transaction to be cancelled by failing its condition
+func isConditionFailureAt(err error, index int) bool {
+	var cancelled *types.TransactionCanceledException
+	if !errors.As(err, &cancelled) || index >= len(cancelled.CancellationReasons) {
+		return false
+	}
+
+	code := cancelled.CancellationReasons[index].Code
+	return code != nil && *code == "ConditionalCheckFailed"
 }
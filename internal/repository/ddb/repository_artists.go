@@ -19,62 +19,113 @@ type ArtistList struct {
 	Count int32
 	Cursor string
 	Items []*setmakerpb.Artist
+	TotalEstimate int64
 }
 
 
+// Paginated list of the tenant's artists, leaving out deleted artists
 func (d *DynamoRepository) ListArtists(ctx context.Context, limit int32, cursor string) (*ArtistList, error) {
-	// decode the cursor
-	c, err := utils.DecodeAttributeMap(cursor)
+	tenantId, err := requireTenant(ctx, "ListArtists")
 	if err != nil {
-		logger.WithField("cursor", cursor).Errorf("ListArtists Repo: Could not decode cursor: %s", err)
 		return nil, err
 	}
 
-	// build DDB scan input
-	input := dynamodb.ScanInput{
-		TableName: aws.String(ArtistsTable),
-		Limit: &limit,
-		ExclusiveStartKey: c,
-	}
-
 	logger.WithFields(logger.Fields{
 		"limit": limit,
 		"cursor": cursor,
+		"tenant": tenantId,
 	}).Info("ListArtists Repo: Scanning dynamo")
 
-	// scan DDB
-	res, err := d.client.Scan(ctx, &input)
-	if err != nil {
-		logger.WithFields(logger.Fields{
-			"limit": limit,
-			"cursor": cursor,
-			"input": input,
-		}).Errorf("ListArtists Repo: Error scanning: %s", err)
-	}
-
-	// encode the return cursor
-	returnCursor, err := utils.EncodeAttributeMap(res.LastEvaluatedKey)
+	// scan DDB until the page is full
+	shape := utils.QueryShape{Tenant: tenantId, Table: ArtistsTable}
+	items, returnCursor, err := d.fillPage(ctx, shape, limit, cursor, func(ctx context.Context, startKey map[string]types.AttributeValue, limit int32) (*fetchedPage, error) {
+		res, err := d.client.Scan(ctx, &dynamodb.ScanInput{
+			TableName: aws.String(ArtistsTable),
+			FilterExpression: aws.String("#tenant = :tenant AND attribute_not_exists(#trash)"),
+			ExpressionAttributeNames: map[string]string{
+				"#tenant": TenantAttribute,
+				"#trash": "Trash",
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":tenant": &types.AttributeValueMemberS{Value: tenantId},
+			},
+			Limit: &limit,
+			ExclusiveStartKey: startKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &fetchedPage{items: res.Items, lastEvaluatedKey: res.LastEvaluatedKey}, nil
+	})
 	if err != nil {
-		logger.WithField("lastEvaluatedKey", res.LastEvaluatedKey).Errorf("ListArtists Repo: Unable to encode attribute map: %s", err)
 		return nil, err
 	}
 
 	// parse results
-	var items []*setmakerpb.Artist
-	if err = attributevalue.UnmarshalListOfMaps(res.Items, &items); err != nil {
+	var artists []*setmakerpb.Artist
+	if err = attributevalue.UnmarshalListOfMaps(items, &artists); err != nil {
 		logger.Errorf("ListArtists Repo: Could not unmarshal results: %s", err)
-		return nil, err
+		return nil, status.Error(codes.Internal, "Error unmarshaling artist data")
 	}
 
 	return &ArtistList{
-		Count: res.Count,
-		Cursor: string(returnCursor),
-		Items: items,
+		Count: int32(len(artists)),
+		Cursor: returnCursor,
+		Items: artists,
+		TotalEstimate: d.itemCount(ArtistsTable, tenantId),
 	}, nil
 }
 
 
+// Get a set of artists by Id, keyed by Id
+// ids with no artist, a deleted one or another tenant's, are left out of the result
+func (d *DynamoRepository) BatchGetArtists(ctx context.Context, ids []uuid.UUID) (map[string]*setmakerpb.Artist, error) {
+	tenantId, err := requireTenant(ctx, "BatchGetArtists")
+	if err != nil {
+		return nil, err
+	}
+
+	logger.WithField("count", len(ids)).Info("BatchGetArtists Repo: Fetching artists")
+
+	items, err := d.batchGet(ctx, ArtistsTable, uniqueIds(ids))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error fetching artists")
+	}
+	items = withoutTrashed(ownedItems(items, tenantId))
+
+	var artists []*setmakerpb.Artist
+	if err = attributevalue.UnmarshalListOfMaps(items, &artists); err != nil {
+		logger.Errorf("BatchGetArtists Repo: Could not unmarshal results: %s", err)
+		return nil, status.Error(codes.Internal, "Error unmarshaling artist data")
+	}
+
+	res := make(map[string]*setmakerpb.Artist, len(artists))
+	for _, artist := range artists {
+		res[artist.Id] = artist
+	}
+
+	return res, nil
+}
+
+
+// Get artist by Id. deleted artists are not found
 func (d *DynamoRepository) GetArtist(ctx context.Context, id uuid.UUID) (*setmakerpb.Artist, error) {
+	return d.getArtist(ctx, id, false)
+}
+
+
+// Get a deleted artist by Id. live artists are not found
+func (d *DynamoRepository) GetDeletedArtist(ctx context.Context, id uuid.UUID) (*setmakerpb.Artist, error) {
+	return d.getArtist(ctx, id, true)
+}
+
+
+func (d *DynamoRepository) getArtist(ctx context.Context, id uuid.UUID, deleted bool) (*setmakerpb.Artist, error) {
+	tenantId, err := requireTenant(ctx, "GetArtist")
+	if err != nil {
+		return nil, err
+	}
+
 	// create key map
 	keys, err := attributevalue.MarshalMap(map[string]string{
 		"Id": *aws.String(id.String()),
@@ -94,8 +145,9 @@ func (d *DynamoRepository) GetArtist(ctx context.Context, id uuid.UUID) (*setmak
 		return nil, status.Error(codes.Internal, "Error fetching result")
 	}
 
-	// check an item was returned
-	if data.Item == nil {
+	// check an item was returned, that it is the tenant's own, and that it is in the trash only
+	// when a deleted artist was asked for
+	if data.Item == nil || !ownedBy(data.Item, tenantId) || isTrashed(data.Item) != deleted {
 		logger.WithField("id", id).Error("GetArtist Repo: No artist found for ID")
 		return nil, status.Error(codes.NotFound, "Artist not found")
 	}
@@ -114,21 +166,131 @@ func (d *DynamoRepository) GetArtist(ctx context.Context, id uuid.UUID) (*setmak
 }
 
 
-func (d *DynamoRepository) PutArtist(ctx context.Context, artist *setmakerpb.Artist) error {
+// Get the tenant's artist by case-insensitive name
+func (d *DynamoRepository) GetArtistByName(ctx context.Context, name string) (*setmakerpb.Artist, error) {
+	tenantId, err := requireTenant(ctx, "GetArtistByName")
+	if err != nil {
+		return nil, err
+	}
+	normalised := utils.NormaliseName(name)
+
+	// resolve the name guard to an artist ID
+	data, err := d.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(ArtistNamesTable),
+		Key: map[string]types.AttributeValue{
+			"Name": &types.AttributeValueMemberS{Value: tenantKey(tenantId, normalised)},
+		},
+	})
+	if err != nil {
+		logger.WithField("name", normalised).Errorf("GetArtistByName Repo: Error fetching from dynamo: %s", err)
+		return nil, status.Error(codes.Internal, "Error fetching result")
+	}
+
+	guard := struct{ ArtistId string }{}
+	if data.Item != nil {
+		if err = attributevalue.UnmarshalMap(data.Item, &guard); err != nil {
+			logger.WithField("data", data.Item).Errorf("GetArtistByName Repo: Could not unmarshal guard: %s", err)
+			return nil, status.Error(codes.Internal, "Error unmarshaling artist name")
+		}
+	}
+
+	id, err := uuid.Parse(guard.ArtistId)
+	if err != nil {
+		logger.WithField("name", normalised).Error("GetArtistByName Repo: No artist found for name")
+		return nil, status.Error(codes.NotFound, "Artist not found")
+	}
+
+	return d.GetArtist(ctx, id)
+}
+
+
+// Put artist
+// The artist's normalised name is reserved with a guard item in the same transaction, so two
+// of a tenant's artists can't share a name. A renamed artist releases its old name.
+// Putting a deleted artist moves it to the trash and releases its name instead, so the name can be
+// reused. Putting it back without DeletedAt restores it, reclaiming the name
+func (d *DynamoRepository) PutArtist(ctx context.Context, artist *setmakerpb.Artist, events ...*setmakerpb.Event) error {
+	tenantId, err := requireTenant(ctx, "PutArtist")
+	if err != nil {
+		return err
+	}
+
 	// create attribute value map
 	item, err := attributevalue.MarshalMap(artist)
 	if err != nil {
 		logger.WithField("data", artist).Errorf("PutArtist Repo: Could not marshalmap: %s", err)
 		return status.Error(codes.InvalidArgument, "Could not map input values for artist")
 	}
+	addTenant(item, tenantId)
+	if err = d.addTrashAttributes(item, artist.Metadata); err != nil {
+		logger.WithField("data", artist).Errorf("PutArtist Repo: Could not set trash expiry: %s", err)
+		return status.Error(codes.InvalidArgument, "Could not map input values for artist")
+	}
 
-	// PutItem to dynamo
-	_, err = d.client.PutItem(ctx, &dynamodb.PutItemInput{
-		TableName: aws.String(ArtistsTable),
-		Item:      item,
-	})
+	// look up the name currently held by the artist. the version condition below guarantees
+	// it hasn't changed by the time the transaction is written
+	previous, err := d.storedArtistName(ctx, artist.Id)
+	if err != nil {
+		return status.Error(codes.Internal, "Failed to persist artist")
+	}
+	if previous != "" {
+		previous = tenantKey(tenantId, previous)
+	}
+
+	name := tenantKey(tenantId, utils.NormaliseName(artist.Name))
+
+	// the put only succeeds if the artist is the tenant's and nobody else has written it since it was read
+	condition, names, values := ownedVersionCondition(tenantId, artist.GetMetadata().GetVersion())
+	put := types.TransactWriteItem{
+		Put: &types.Put{
+			TableName: aws.String(ArtistsTable),
+			Item: item,
+			ConditionExpression: condition,
+			ExpressionAttributeNames: names,
+			ExpressionAttributeValues: values,
+		},
+	}
+
+	if artist.GetMetadata().GetDeletedAt() != "" {
+		return d.trashArtist(ctx, tenantId, artist, put, previous, events)
+	}
+
+	changes := []types.TransactWriteItem{
+		put,
+		{
+			// reserve the name unless another artist already holds it
+			Put: &types.Put{
+				TableName: aws.String(ArtistNamesTable),
+				Item: map[string]types.AttributeValue{
+					"Name": &types.AttributeValueMemberS{Value: name},
+					"ArtistId": &types.AttributeValueMemberS{Value: artist.Id},
+					TenantAttribute: &types.AttributeValueMemberS{Value: tenantId},
+				},
+				ConditionExpression: aws.String("attribute_not_exists(#name) OR ArtistId = :id"),
+				ExpressionAttributeNames: map[string]string{"#name": "Name"},
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":id": &types.AttributeValueMemberS{Value: artist.Id},
+				},
+			},
+		},
+	}
+
+	if previous != "" && previous != name {
+		changes = append(changes, releaseArtistName(previous, artist.Id))
+	}
+
+	// put the artist, its name and its events in one transaction
+	err = d.transactWithEvents(ctx, tenantId, changes, events)
+	if isConditionFailureAt(err, 1) {
+		logger.WithField("name", name).Warn("PutArtist Repo: Name already taken")
+		return status.Error(codes.AlreadyExists, "An artist with this name already exists")
+	}
+	if isConditionFailure(err) {
+		logger.WithField("data", artist).Warn("PutArtist Repo: Version conflict")
+		return status.Error(codes.Aborted, "Artist was modified by another request. Fetch it and try again")
+	}
 	if err != nil {
-		logger.WithField("data", artist).Errorf("PutArtist Repo: Could not PutItem: %s", err)
+		logger.WithField("data", artist).Errorf("PutArtist Repo: Could not write transaction: %s", err)
 		return status.Error(codes.Internal, "Failed to persist artist")
 	}
 
@@ -137,19 +299,68 @@ func (d *DynamoRepository) PutArtist(ctx context.Context, artist *setmakerpb.Art
 }
 
 
-func (d *DynamoRepository) DeleteArtist(ctx context.Context, id uuid.UUID) error {
-	logger.WithField("id", id).Infof("DeleteArtist Repo: Deleting artist")
+// put a deleted artist, releasing the name it held
+func (d *DynamoRepository) trashArtist(ctx context.Context, tenantId string, artist *setmakerpb.Artist, put types.TransactWriteItem, previous string, events []*setmakerpb.Event) error {
+	changes := []types.TransactWriteItem{put}
+	if previous != "" {
+		changes = append(changes, releaseArtistName(previous, artist.Id))
+	}
 
-	_, err := d.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+	err := d.transactWithEvents(ctx, tenantId, changes, events)
+	if isConditionFailure(err) {
+		logger.WithField("data", artist).Warn("PutArtist Repo: Version conflict")
+		return status.Error(codes.Aborted, "Artist was modified by another request. Fetch it and try again")
+	}
+	if err != nil {
+		logger.WithField("data", artist).Errorf("PutArtist Repo: Could not write transaction: %s", err)
+		return status.Error(codes.Internal, "Failed to persist artist")
+	}
+
+	logger.WithField("id", artist.Id).Info("PutArtist Repo: Artist moved to trash")
+	return nil
+}
+
+
+// normalised name of a stored artist, or an empty string if the artist doesn't exist
+func (d *DynamoRepository) storedArtistName(ctx context.Context, id string) (string, error) {
+	data, err := d.client.GetItem(ctx, &dynamodb.GetItemInput{
 		TableName: aws.String(ArtistsTable),
 		Key: map[string]types.AttributeValue{
-			"Id": &types.AttributeValueMemberS{Value: id.String()},
+			"Id": &types.AttributeValueMemberS{Value: id},
 		},
+		ProjectionExpression: aws.String("#name"),
+		ExpressionAttributeNames: map[string]string{"#name": "Name"},
+		ConsistentRead: aws.Bool(true),
 	})
 	if err != nil {
-		logger.WithField("id", id).Errorf("DeleteArtist Repo: Could not delete artist: %s", err)
-		return status.Error(codes.Internal, "Artist could not be deleted")
+		logger.WithField("id", id).Errorf("storedArtistName Repo: Error fetching from dynamo: %s", err)
+		return "", err
 	}
 
-	return nil
+	stored := struct{ Name string }{}
+	if err = attributevalue.UnmarshalMap(data.Item, &stored); err != nil {
+		logger.WithField("data", data.Item).Errorf("storedArtistName Repo: Could not unmarshal item: %s", err)
+		return "", err
+	}
+
+	return utils.NormaliseName(stored.Name), nil
+}
+
+
+// transaction item releasing a name guard held by an artist
+// guards held by other artists are left alone - the condition fails the transaction instead
+func releaseArtistName(name string, artistId string) types.TransactWriteItem {
+	return types.TransactWriteItem{
+		Delete: &types.Delete{
+			TableName: aws.String(ArtistNamesTable),
+			Key: map[string]types.AttributeValue{
+				"Name": &types.AttributeValueMemberS{Value: name},
+			},
+			ConditionExpression: aws.String("attribute_not_exists(#name) OR ArtistId = :id"),
+			ExpressionAttributeNames: map[string]string{"#name": "Name"},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":id": &types.AttributeValueMemberS{Value: artistId},
+			},
+		},
+	}
 }
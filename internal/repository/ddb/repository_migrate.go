@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/pete-robinson/set-maker-grpc/internal/utils"
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// metadata timestamps of a stored item
+type storedTimestamps struct {
+	CreatedAt string
+	UpdatedAt string
+	DeletedAt string
+}
+
+
+// Rewrite the metadata timestamps of every artist, song and setlist, across all tenants, in
+// utils.TimestampLayout. Songs also get their top-level UpdatedAt rewritten so the UpdatedAt index
+// sorts correctly. Versions are left alone and no events are raised, as the records haven't changed.
+// Safe to run more than once. Returns the number of items rewritten
+func (d *DynamoRepository) MigrateTimestamps(ctx context.Context) (int, error) {
+	migrated := 0
+	for _, table := range []string{ArtistsTable, SongsTable, SetlistsTable} {
+		count, err := d.migrateTable(ctx, table)
+		migrated += count
+		if err != nil {
+			logger.WithField("table", table).Errorf("MigrateTimestamps Repo: Could not migrate items: %s", err)
+			return migrated, status.Error(codes.Internal, "Could not migrate timestamps")
+		}
+	}
+
+	logger.WithField("migrated", migrated).Info("MigrateTimestamps Repo: Timestamps rewritten")
+	return migrated, nil
+}
+
+
+// rewrite the timestamps of a table's items that aren't already in the current layout
+func (d *DynamoRepository) migrateTable(ctx context.Context, table string) (int, error) {
+	migrated := 0
+	var startKey map[string]types.AttributeValue
+	for {
+		res, err := d.client.Scan(ctx, &dynamodb.ScanInput{
+			TableName: aws.String(table),
+			ProjectionExpression: aws.String("Id, #meta"),
+			ExpressionAttributeNames: map[string]string{"#meta": "Metadata"},
+			ExclusiveStartKey: startKey,
+		})
+		if err != nil {
+			return migrated, err
+		}
+
+		for _, item := range res.Items {
+			ok, err := d.migrateItem(ctx, table, item)
+			if err != nil {
+				return migrated, err
+			}
+			if ok {
+				migrated++
+			}
+		}
+
+		startKey = res.LastEvaluatedKey
+		if startKey == nil {
+			return migrated, nil
+		}
+	}
+}
+
+
+// rewrite the timestamps of one item, reporting whether anything was written
+// items with timestamps that can't be parsed are logged and left as they are
+func (d *DynamoRepository) migrateItem(ctx context.Context, table string, item map[string]types.AttributeValue) (bool, error) {
+	stored := struct {
+		Id       string
+		Metadata storedTimestamps
+	}{}
+	if err := attributevalue.UnmarshalMap(item, &stored); err != nil {
+		return false, err
+	}
+
+	migrated, err := migrateTimestamps(stored.Metadata)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"table": table,
+			"id": stored.Id,
+		}).Warnf("MigrateTimestamps Repo: Skipping item: %s", err)
+		return false, nil
+	}
+	if migrated == stored.Metadata {
+		return false, nil
+	}
+
+	names := map[string]string{
+		"#meta": "Metadata",
+		"#createdAt": "CreatedAt",
+		"#updatedAt": "UpdatedAt",
+		"#deletedAt": "DeletedAt",
+	}
+	values := map[string]types.AttributeValue{
+		":createdAt": &types.AttributeValueMemberS{Value: migrated.CreatedAt},
+		":updatedAt": &types.AttributeValueMemberS{Value: migrated.UpdatedAt},
+		":deletedAt": &types.AttributeValueMemberS{Value: migrated.DeletedAt},
+		":previous": &types.AttributeValueMemberS{Value: stored.Metadata.UpdatedAt},
+	}
+
+	update := "SET #meta.#createdAt = :createdAt, #meta.#updatedAt = :updatedAt, #meta.#deletedAt = :deletedAt"
+	if table == SongsTable {
+		update += ", UpdatedAt = :updatedAt"
+	}
+
+	// an item written since the scan already has current timestamps, so is left alone
+	_, err = d.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(table),
+		Key: map[string]types.AttributeValue{"Id": item["Id"]},
+		UpdateExpression: aws.String(update),
+		ConditionExpression: aws.String("#meta.#updatedAt = :previous"),
+		ExpressionAttributeNames: names,
+		ExpressionAttributeValues: values,
+	})
+	if isConditionFailure(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+
+// timestamps rewritten in the current layout. empty timestamps stay empty
+func migrateTimestamps(stored storedTimestamps) (storedTimestamps, error) {
+	var migrated storedTimestamps
+	for _, field := range []struct {
+		from string
+		to   *string
+	}{
+		{stored.CreatedAt, &migrated.CreatedAt},
+		{stored.UpdatedAt, &migrated.UpdatedAt},
+		{stored.DeletedAt, &migrated.DeletedAt},
+	} {
+		value, err := utils.NormaliseTimestamp(field.from)
+		if err != nil {
+			return stored, err
+		}
+		*field.to = value
+	}
+
+	return migrated, nil
+}
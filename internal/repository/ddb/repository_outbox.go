@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/google/uuid"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// outbox entry states
+const (
+	OutboxPending = "PENDING"
+	OutboxSent    = "SENT"
+	// publishing was abandoned after too many failed attempts
+	OutboxFailed = "FAILED"
+)
+
+// Pending entries are read from the Status index, which is keyed on Status and NextAttemptAt so
+// entries that are backing off never crowd out those that are due. Sent entries get an ExpiresAt
+// epoch time in seconds, the outbox table's TTL attribute, so DynamoDB removes them once they have
+// been kept for OutboxSentRetention. Failed entries are kept for inspection
+const (
+	OutboxStatusIndex = "Status-NextAttemptAt-index"
+	OutboxSentRetention = 7 * 24 * time.Hour
+)
+
+// An event waiting to be published, written in the same transaction as the change that raised it
+type OutboxEntry struct {
+	Id        string
+	Status    string
+	EventType string
+	// proto encoded setmakerpb.Event
+	Payload   []byte
+	Attempts  int32
+	LastError string
+	CreatedAt string
+	// unix millis before which the entry shouldn't be retried
+	NextAttemptAt int64
+	SentAt        string
+	// epoch seconds after which a sent entry is removed. unset until the entry is sent
+	ExpiresAt int64 `dynamodbav:",omitempty"`
+}
+
+
+// Decode the event carried by an entry
+func (o *OutboxEntry) Event() (*setmakerpb.Event, error) {
+	event := &setmakerpb.Event{}
+	if err := proto.Unmarshal(o.Payload, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+
+// Build a pending outbox entry for an event
+func NewOutboxEntry(event *setmakerpb.Event) (*OutboxEntry, error) {
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &OutboxEntry{
+		Id:            uuid.New().String(),
+		Status:        OutboxPending,
+		EventType:     event.EventType.String(),
+		Payload:       payload,
+		CreatedAt:     now.UTC().Format(time.RFC3339Nano),
+		NextAttemptAt: now.UnixMilli(),
+	}, nil
+}
+
+
+// Pending outbox entries that are due to be published, longest due first
+func (d *DynamoRepository) ListPendingOutbox(ctx context.Context, limit int32) ([]*OutboxEntry, error) {
+	res, err := d.client.Query(ctx, &dynamodb.QueryInput{
+		TableName: aws.String(OutboxTable),
+		IndexName: aws.String(OutboxStatusIndex),
+		KeyConditionExpression: aws.String("#status = :pending AND NextAttemptAt <= :now"),
+		ExpressionAttributeNames: map[string]string{
+			"#status": "Status",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pending": &types.AttributeValueMemberS{Value: OutboxPending},
+			":now": &types.AttributeValueMemberN{Value: strconv.FormatInt(time.Now().UnixMilli(), 10)},
+		},
+		Limit: &limit,
+	})
+	if err != nil {
+		logger.Errorf("ListPendingOutbox Repo: Error response from dynamo: %s", err)
+		return nil, err
+	}
+
+	var entries []*OutboxEntry
+	if err = attributevalue.UnmarshalListOfMaps(res.Items, &entries); err != nil {
+		logger.Errorf("ListPendingOutbox Repo: Could not unmarshal results: %s", err)
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+
+// Mark an entry as published, to expire after OutboxSentRetention
+// Conditional on the entry still being pending so a concurrent relay can't overwrite a failure
+func (d *DynamoRepository) MarkOutboxSent(ctx context.Context, id string) error {
+	now := time.Now()
+	_, err := d.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(OutboxTable),
+		Key: map[string]types.AttributeValue{
+			"Id": &types.AttributeValueMemberS{Value: id},
+		},
+		UpdateExpression: aws.String("SET #status = :sent, SentAt = :sentAt, ExpiresAt = :expiresAt"),
+		ConditionExpression: aws.String("#status = :pending"),
+		ExpressionAttributeNames: map[string]string{
+			"#status": "Status",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":sent": &types.AttributeValueMemberS{Value: OutboxSent},
+			":pending": &types.AttributeValueMemberS{Value: OutboxPending},
+			":sentAt": &types.AttributeValueMemberS{Value: now.UTC().Format(time.RFC3339Nano)},
+			":expiresAt": &types.AttributeValueMemberN{Value: strconv.FormatInt(now.Add(OutboxSentRetention).Unix(), 10)},
+		},
+	})
+	if err != nil {
+		logger.WithField("id", id).Errorf("MarkOutboxSent Repo: Could not update entry: %s", err)
+		return status.Error(codes.Internal, "Failed to mark outbox entry sent")
+	}
+
+	return nil
+}
+
+
+// Record a failed publish attempt
+// Conditional on the entry still being pending, so an entry a concurrent relay has since marked
+// sent is left alone
+func (d *DynamoRepository) RescheduleOutbox(ctx context.Context, entry *OutboxEntry) error {
+	item, err := attributevalue.MarshalMap(entry)
+	if err != nil {
+		logger.WithField("id", entry.Id).Errorf("RescheduleOutbox Repo: Could not marshal map: %s", err)
+		return status.Error(codes.Internal, "Could not map outbox entry")
+	}
+
+	_, err = d.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(OutboxTable),
+		Item: item,
+		ConditionExpression: aws.String("#status = :pending"),
+		ExpressionAttributeNames: map[string]string{
+			"#status": "Status",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pending": &types.AttributeValueMemberS{Value: OutboxPending},
+		},
+	})
+	if isConditionFailure(err) {
+		logger.WithField("id", entry.Id).Info("RescheduleOutbox Repo: Entry is no longer pending, leaving it")
+		return nil
+	}
+	if err != nil {
+		logger.WithField("id", entry.Id).Errorf("RescheduleOutbox Repo: Could not PutItem: %s", err)
+		return status.Error(codes.Internal, "Failed to reschedule outbox entry")
+	}
+
+	return nil
+}
+
+
+// Transaction items that write a tenant's events to the outbox
+func outboxPuts(events []*setmakerpb.Event, tenantId string) ([]types.TransactWriteItem, error) {
+	items := make([]types.TransactWriteItem, 0, len(events))
+	for _, event := range events {
+		item, err := outboxItem(event, tenantId)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, types.TransactWriteItem{
+			Put: &types.Put{
+				TableName: aws.String(OutboxTable),
+				Item: item,
+			},
+		})
+	}
+
+	return items, nil
+}
+
+
+// build the outbox item for an event, stamped with the tenant that raised it
+func outboxItem(event *setmakerpb.Event, tenantId string) (map[string]types.AttributeValue, error) {
+	event.TenantId = tenantId
+	entry, err := NewOutboxEntry(event)
+	if err != nil {
+		logger.WithField("event", event).Errorf("outboxItem Repo: Could not build outbox entry: %s", err)
+		return nil, err
+	}
+
+	item, err := attributevalue.MarshalMap(entry)
+	if err != nil {
+		logger.WithField("event", event).Errorf("outboxItem Repo: Could not marshal map: %s", err)
+		return nil, err
+	}
+
+	return item, nil
+}
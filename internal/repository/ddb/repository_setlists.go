@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/google/uuid"
+	"github.com/pete-robinson/set-maker-grpc/internal/utils"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SetlistList struct {
+	Count int32
+	Cursor string
+	Items []*setmakerpb.Setlist
+}
+
+
+// Paginated list of the tenant's setlists
+func (d *DynamoRepository) ListSetlists(ctx context.Context, limit int32, cursor string) (*SetlistList, error) {
+	tenantId, err := requireTenant(ctx, "ListSetlists")
+	if err != nil {
+		return nil, err
+	}
+
+	logger.WithFields(logger.Fields{
+		"limit": limit,
+		"cursor": cursor,
+		"tenant": tenantId,
+	}).Info("ListSetlists Repo: Scanning dynamo")
+
+	// scan DDB until the page is full
+	shape := utils.QueryShape{Tenant: tenantId, Table: SetlistsTable}
+	items, returnCursor, err := d.fillPage(ctx, shape, limit, cursor, func(ctx context.Context, startKey map[string]types.AttributeValue, limit int32) (*fetchedPage, error) {
+		res, err := d.client.Scan(ctx, &dynamodb.ScanInput{
+			TableName: aws.String(SetlistsTable),
+			FilterExpression: aws.String("#tenant = :tenant"),
+			ExpressionAttributeNames: map[string]string{"#tenant": TenantAttribute},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":tenant": &types.AttributeValueMemberS{Value: tenantId},
+			},
+			Limit: &limit,
+			ExclusiveStartKey: startKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &fetchedPage{items: res.Items, lastEvaluatedKey: res.LastEvaluatedKey}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// parse results
+	var setlists []*setmakerpb.Setlist
+	if err = attributevalue.UnmarshalListOfMaps(items, &setlists); err != nil {
+		logger.Errorf("ListSetlists Repo: Could not unmarshal results: %s", err)
+		return nil, status.Error(codes.Internal, "Error unmarshaling setlist data")
+	}
+
+	return &SetlistList{
+		Count: int32(len(setlists)),
+		Cursor: returnCursor,
+		Items: setlists,
+	}, nil
+}
+
+
+// Get setlist by Id
+func (d *DynamoRepository) GetSetlist(ctx context.Context, id uuid.UUID) (*setmakerpb.Setlist, error) {
+	tenantId, err := requireTenant(ctx, "GetSetlist")
+	if err != nil {
+		return nil, err
+	}
+
+	// create key map
+	keys, err := attributevalue.MarshalMap(map[string]string{
+		"Id": *aws.String(id.String()),
+	})
+	if err != nil {
+		logger.WithField("id", id).Errorf("GetSetlist Repo: could not marshal map: %s", err)
+		return nil, status.Error(codes.InvalidArgument, "Invalid UUID")
+	}
+
+	// fetch item from dynamo
+	data, err := d.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(SetlistsTable),
+		Key: keys,
+	})
+	if err != nil {
+		logger.WithField("id", id).Errorf("GetSetlist Repo: Error fetching result from dynamo: %s", err)
+		return nil, status.Error(codes.Internal, "Error fetching result")
+	}
+
+	// check a result was returned and that it is the tenant's own
+	if data.Item == nil || !ownedBy(data.Item, tenantId) {
+		logger.WithField("id", id).Error("GetSetlist Repo: No setlist found for ID")
+		return nil, status.Error(codes.NotFound, "Setlist not found")
+	}
+
+	logger.WithField("data", data.Item).Info("GetSetlist Repo: Setlist found")
+
+	res := &setmakerpb.Setlist{}
+	if err = attributevalue.UnmarshalMap(data.Item, res); err != nil {
+		logger.WithField("data", data.Item).Errorf("GetSetlist Repo: could not unmarshal data: %s", err)
+		return nil, status.Error(codes.Internal, "Error unmarshaling setlist data")
+	}
+
+	return res, nil
+}
+
+
+// Put setlist
+// The write is version checked like artists and songs, so concurrent updates can't overwrite each other
+func (d *DynamoRepository) PutSetlist(ctx context.Context, setlist *setmakerpb.Setlist) error {
+	tenantId, err := requireTenant(ctx, "PutSetlist")
+	if err != nil {
+		return err
+	}
+
+	// create attribute value map
+	item, err := attributevalue.MarshalMap(setlist)
+	if err != nil {
+		logger.WithField("setlist", setlist).Errorf("PutSetlist Repo: Could not marshal map: %s", err)
+		return status.Error(codes.InvalidArgument, "Could not map input values for setlist")
+	}
+	addTenant(item, tenantId)
+
+	// PutItem to dynamo, only if the setlist is the tenant's and nobody else has written it since it was read
+	condition, names, values := ownedVersionCondition(tenantId, setlist.GetMetadata().GetVersion())
+	_, err = d.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(SetlistsTable),
+		Item: item,
+		ConditionExpression: condition,
+		ExpressionAttributeNames: names,
+		ExpressionAttributeValues: values,
+	})
+	if isConditionFailure(err) {
+		logger.WithField("id", setlist.Id).Warn("PutSetlist Repo: Version conflict")
+		return status.Error(codes.Aborted, "Setlist was modified by another request. Fetch it and try again")
+	}
+	if err != nil {
+		logger.WithField("setlist", setlist).Errorf("PutSetlist Repo: Could not PutItem: %s", err)
+		return status.Error(codes.Internal, "Failed to persist setlist")
+	}
+
+	logger.WithField("id", setlist.Id).Info("PutSetlist Repo: Setlist persisted successfully")
+	return nil
+}
+
+
+// Delete setlist
+func (d *DynamoRepository) DeleteSetlist(ctx context.Context, id uuid.UUID) error {
+	tenantId, err := requireTenant(ctx, "DeleteSetlist")
+	if err != nil {
+		return err
+	}
+
+	logger.WithField("id", id).Infof("DeleteSetlist Repo: Deleting setlist")
+
+	condition, names, values := ownedCondition(tenantId, nil, nil, nil)
+	_, err = d.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(SetlistsTable),
+		Key: map[string]types.AttributeValue{
+			"Id": &types.AttributeValueMemberS{Value: id.String()},
+		},
+		ConditionExpression: condition,
+		ExpressionAttributeNames: names,
+		ExpressionAttributeValues: values,
+	})
+	if isConditionFailure(err) {
+		logger.WithField("id", id).Warn("DeleteSetlist Repo: Setlist belongs to another tenant")
+		return status.Error(codes.NotFound, "Setlist not found")
+	}
+	if err != nil {
+		logger.WithField("id", id).Errorf("DeleteSetlist Repo: Could not delete setlist: %s", err)
+		return status.Error(codes.Internal, "Setlist could not be deleted")
+	}
+
+	return nil
+}
@@ -2,6 +2,9 @@ package repository
 
 import (
 	"context"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
@@ -19,77 +22,256 @@ type SongList struct {
 	Count int32
 	Cursor string
 	Items []*setmakerpb.Song
+	TotalEstimate int64
 }
 
+// Every song carries a Catalog attribute, constant per tenant, and a top-level copy of Metadata.UpdatedAt
+// so the ordering indexes can sort a tenant's whole catalog within one partition
+const (
+	SongCatalog = "songs"
+	SongTitleIndex = "Catalog-Title-index"
+	SongUpdatedAtIndex = "Catalog-UpdatedAt-index"
+	SongArtistIndex = "ArtistId-index"
+	SongKeyIndex = "Key-index"
+)
 
-// Paginated list of songs
-func (d *DynamoRepository) ListSongs(ctx context.Context, limit int32, cursor string) (*SongList, error) {
-	// decode the cursor
-	c, err := utils.DecodeAttributeMap(cursor)
-	if err != nil {
-		logger.WithField("cursor", cursor).Errorf("ListSongs Repo: Could not decode cursor: %s", err)
-		return nil, err
+// Filter and ordering options for ListSongs. Zero values match every live song.
+// CreatedAfter is inclusive and CreatedBefore exclusive, and the zero time leaves either unbounded.
+// They are compared with parsed creation times rather than as strings, so songs whose timestamps
+// are still in the legacy layout are filtered correctly.
+// Deleted lists songs in the trash instead of live ones
+type SongQuery struct {
+	Key setmakerpb.Key
+	Tonality setmakerpb.Tonality
+	ArtistId string
+	CreatedAfter time.Time
+	CreatedBefore time.Time
+	OrderBy setmakerpb.SongOrder
+	Descending bool
+	Deleted bool
+}
+
+
+// The index a song query runs against, or "" for a table scan
+// ordering takes priority, then the artist filter, then the key filter. Deleted songs with no
+// other filter are listed from the Trash index
+func (q SongQuery) Index() string {
+	switch {
+	case q.OrderBy == setmakerpb.SongOrder_SONG_ORDER_TITLE:
+		return SongTitleIndex
+	case q.OrderBy == setmakerpb.SongOrder_SONG_ORDER_UPDATED_AT:
+		return SongUpdatedAtIndex
+	case q.ArtistId != "":
+		return SongArtistIndex
+	case q.Key != setmakerpb.Key_KEY_UNKNOWN:
+		return SongKeyIndex
+	case q.Deleted:
+		return TrashIndex
 	}
 
-	logger.WithFields(logger.Fields{
-		"limit": limit,
-		"cursor": cursor,
-	}).Info("ListSongs Repo: Scanning dynamo")
+	return ""
+}
 
-	// query DDB
-	res, err := d.client.Scan(ctx, &dynamodb.ScanInput{
-		TableName: aws.String(SongsTable),
-		Limit: &limit,
-		ExclusiveStartKey: c,
-	})
+
+// The shape of a song query, used to bind cursors to it
+func (q SongQuery) Shape() utils.QueryShape {
+	return utils.QueryShape{
+		Table: SongsTable,
+		Index: q.Index(),
+		Params: map[string]string{
+			"key": strconv.Itoa(int(q.Key)),
+			"tonality": strconv.Itoa(int(q.Tonality)),
+			"artistId": q.ArtistId,
+			"createdAfter": formatBound(q.CreatedAfter),
+			"createdBefore": formatBound(q.CreatedBefore),
+			"orderBy": strconv.Itoa(int(q.OrderBy)),
+			"descending": strconv.FormatBool(q.Descending),
+			"deleted": strconv.FormatBool(q.Deleted),
+		},
+	}
+}
+
+
+// a creation time bound as it appears in a query shape
+func formatBound(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return utils.FormatTimestamp(t)
+}
+
+
+// Whether a stored creation timestamp falls within the query's creation time bounds
+// timestamps that can't be parsed never match a bounded query
+func (q SongQuery) CreatedInRange(createdAt string) bool {
+	if q.CreatedAfter.IsZero() && q.CreatedBefore.IsZero() {
+		return true
+	}
+
+	t, err := utils.ParseTimestamp(createdAt)
 	if err != nil {
-		logger.Errorf("ListSongs Repo: Error response from dynamo: %s", err)
-		return nil, err
+		return false
+	}
+	if !q.CreatedAfter.IsZero() && t.Before(q.CreatedAfter) {
+		return false
+	}
+	if !q.CreatedBefore.IsZero() && !t.Before(q.CreatedBefore) {
+		return false
 	}
 
-	return d.buildPaginatedResponse(res.Items, res.Count, res.LastEvaluatedKey)
+	return true
 }
 
 
-// Paginated list of songs by artistId
-func (d *DynamoRepository) ListSongsByArtist(ctx context.Context, limit int32, cursor string, artistId string) (*SongList, error) {
-	// decode the cursor
-	c, err := utils.DecodeAttributeMap(cursor)
+// Paginated list of the tenant's songs matching a query
+// Ordered queries use the Catalog indexes; otherwise the ArtistId or Key index is used when
+// that filter is set, falling back to a scan. Remaining filters run as a FilterExpression,
+// apart from the creation time bounds, which are applied to each fetched page
+func (d *DynamoRepository) ListSongs(ctx context.Context, limit int32, cursor string, query SongQuery) (*SongList, error) {
+	tenantId, err := requireTenant(ctx, "ListSongs")
 	if err != nil {
-		logger.WithField("cursor", cursor).Errorf("ListSongsByArtist Repo: Could not decode cursor: %s", err)
 		return nil, err
 	}
 
 	logger.WithFields(logger.Fields{
 		"limit": limit,
 		"cursor": cursor,
-		"artistId": artistId,
-	}).Info("ListSongsByArtist Repo: Scanning dynamo")
+		"query": query,
+		"tenant": tenantId,
+	}).Info("ListSongs Repo: Querying dynamo")
+
+	names := make(map[string]string)
+	values := make(map[string]types.AttributeValue)
+
+	index := query.Index()
+	keyCondition, indexed := songKeyCondition(index, tenantId, values)
+	filter := songFilter(query, tenantId, indexed, names, values)
+
+	shape := query.Shape()
+	shape.Tenant = tenantId
+
+	var fetch pageFetcher
+	if index == "" {
+		fetch = func(ctx context.Context, startKey map[string]types.AttributeValue, limit int32) (*fetchedPage, error) {
+			res, err := d.client.Scan(ctx, &dynamodb.ScanInput{
+				TableName: aws.String(SongsTable),
+				FilterExpression: filter,
+				ExpressionAttributeNames: names,
+				ExpressionAttributeValues: values,
+				Limit: &limit,
+				ExclusiveStartKey: startKey,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &fetchedPage{items: res.Items, lastEvaluatedKey: res.LastEvaluatedKey}, nil
+		}
+	} else {
+		fetch = func(ctx context.Context, startKey map[string]types.AttributeValue, limit int32) (*fetchedPage, error) {
+			res, err := d.client.Query(ctx, &dynamodb.QueryInput{
+				TableName: aws.String(SongsTable),
+				IndexName: aws.String(index),
+				KeyConditionExpression: aws.String(keyCondition),
+				FilterExpression: filter,
+				ExpressionAttributeNames: names,
+				ExpressionAttributeValues: values,
+				ScanIndexForward: aws.Bool(!query.Descending),
+				Limit: &limit,
+				ExclusiveStartKey: startKey,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &fetchedPage{items: res.Items, lastEvaluatedKey: res.LastEvaluatedKey}, nil
+		}
+	}
 
-	// query ddb
-	res, err := d.client.Query(ctx, &dynamodb.QueryInput{
-		TableName: aws.String(SongsTable),
-		IndexName: aws.String("ArtistId-index"),
-		KeyConditionExpression: aws.String("ArtistId = :artistId"),
-		ExpressionAttributeValues: map[string]types.AttributeValue{
-			":artistId": &types.AttributeValueMemberS{
-				Value: artistId,
-			},
-		},
-		Limit: &limit,
-		ExclusiveStartKey: c,
-	})
+	list, err := d.listSongs(ctx, shape, limit, cursor, createdInRange(fetch, query))
 	if err != nil {
-		logger.Errorf("ListSongsByArtist Repo: Error response from dynamo: %s", err)
 		return nil, err
 	}
 
-	return d.buildPaginatedResponse(res.Items, res.Count, res.LastEvaluatedKey)
+	// the estimate is of the live catalog, so trash listings go without one
+	if !query.Deleted {
+		list.TotalEstimate = d.itemCount(SongsTable, tenantId)
+	}
+
+	return list, nil
 }
 
 
-// Get song by Id
+// drop songs created outside the query's creation time bounds from each fetched page
+// a FilterExpression could only compare the stored timestamps as strings
+func createdInRange(fetch pageFetcher, query SongQuery) pageFetcher {
+	if query.CreatedAfter.IsZero() && query.CreatedBefore.IsZero() {
+		return fetch
+	}
+
+	return func(ctx context.Context, startKey map[string]types.AttributeValue, limit int32) (*fetchedPage, error) {
+		page, err := fetch(ctx, startKey, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		items := page.items[:0]
+		for _, item := range page.items {
+			if query.CreatedInRange(itemCreatedAt(item)) {
+				items = append(items, item)
+			}
+		}
+		page.items = items
+
+		return page, nil
+	}
+}
+
+
+// the Metadata.CreatedAt of a stored item, or "" if it has none
+func itemCreatedAt(item map[string]types.AttributeValue) string {
+	meta, ok := item["Metadata"].(*types.AttributeValueMemberM)
+	if !ok {
+		return ""
+	}
+	createdAt, ok := meta.Value["CreatedAt"].(*types.AttributeValueMemberS)
+	if !ok {
+		return ""
+	}
+
+	return createdAt.Value
+}
+
+
+// Paginated list of songs by artistId
+func (d *DynamoRepository) ListSongsByArtist(ctx context.Context, limit int32, cursor string, artistId string) (*SongList, error) {
+	return d.ListSongs(ctx, limit, cursor, SongQuery{ArtistId: artistId})
+}
+
+
+// Paginated list of songs in a key and tonality
+// Queries the Key-index and filters on tonality
+func (d *DynamoRepository) ListSongsByKey(ctx context.Context, limit int32, cursor string, key setmakerpb.Key, tonality setmakerpb.Tonality) (*SongList, error) {
+	return d.ListSongs(ctx, limit, cursor, SongQuery{Key: key, Tonality: tonality})
+}
+
+
+// Get song by Id. deleted songs are not found
 func (d *DynamoRepository) GetSong(ctx context.Context, id uuid.UUID) (*setmakerpb.Song, error) {
+	return d.getSong(ctx, id, false)
+}
+
+
+// Get a deleted song by Id. live songs are not found
+func (d *DynamoRepository) GetDeletedSong(ctx context.Context, id uuid.UUID) (*setmakerpb.Song, error) {
+	return d.getSong(ctx, id, true)
+}
+
+
+func (d *DynamoRepository) getSong(ctx context.Context, id uuid.UUID, deleted bool) (*setmakerpb.Song, error) {
+	tenantId, err := requireTenant(ctx, "GetSong")
+	if err != nil {
+		return nil, err
+	}
+
 	// create key map
 	keys, err := attributevalue.MarshalMap(map[string]string{
 		"Id": *aws.String(id.String()),
@@ -109,8 +291,9 @@ func (d *DynamoRepository) GetSong(ctx context.Context, id uuid.UUID) (*setmaker
 		return nil, status.Error(codes.Internal, "error fetching results")
 	}
 
-	// check a result was returned
-	if data.Item == nil {
+	// check a result was returned, that it is the tenant's own, and that it is in the trash only
+	// when a deleted song was asked for
+	if data.Item == nil || !ownedBy(data.Item, tenantId) || isTrashed(data.Item) != deleted {
 		logger.WithField("id", id).Error("GetSong Repo: No song found for ID")
 		return nil, status.Error(codes.NotFound, "Song not found")
 	}
@@ -127,22 +310,70 @@ func (d *DynamoRepository) GetSong(ctx context.Context, id uuid.UUID) (*setmaker
 }
 
 
+// Get a set of songs by Id, keyed by Id
+// ids with no song, a deleted one or another tenant's, are left out of the result
+func (d *DynamoRepository) BatchGetSongs(ctx context.Context, ids []uuid.UUID) (map[string]*setmakerpb.Song, error) {
+	tenantId, err := requireTenant(ctx, "BatchGetSongs")
+	if err != nil {
+		return nil, err
+	}
+
+	logger.WithField("count", len(ids)).Info("BatchGetSongs Repo: Fetching songs")
+
+	items, err := d.batchGet(ctx, SongsTable, uniqueIds(ids))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error fetching songs")
+	}
+	items = withoutTrashed(ownedItems(items, tenantId))
+
+	var songs []*setmakerpb.Song
+	if err = attributevalue.UnmarshalListOfMaps(items, &songs); err != nil {
+		logger.Errorf("BatchGetSongs Repo: Could not unmarshal results: %s", err)
+		return nil, status.Error(codes.Internal, "Error unmarshaling song data")
+	}
+
+	res := make(map[string]*setmakerpb.Song, len(songs))
+	for _, song := range songs {
+		res[song.Id] = song
+	}
+
+	return res, nil
+}
+
+
 // Put Song
-func (d *DynamoRepository) PutSong(ctx context.Context, song *setmakerpb.Song) error {
+// Putting a deleted song moves it to the trash. Putting it back without DeletedAt restores it
+func (d *DynamoRepository) PutSong(ctx context.Context, song *setmakerpb.Song, events ...*setmakerpb.Event) error {
+	tenantId, err := requireTenant(ctx, "PutSong")
+	if err != nil {
+		return err
+	}
+
 	// create attribute value map
-	item, err := attributevalue.MarshalMap(song)
+	item, err := d.songItem(song, tenantId)
 	if err != nil {
 		logger.WithField("song", song).Errorf("PutSong Repo: Could not marshal map: %s", err)
 		return status.Error(codes.InvalidArgument, "Could not map input values for song")
 	}
 
-	// PutItem to dynamo
-	_, err = d.client.PutItem(ctx, &dynamodb.PutItemInput{
-		TableName: aws.String(SongsTable),
-		Item: item,
-	})
+	// put the song and its events in one transaction
+	// the put only succeeds if the song is the tenant's and nobody else has written it since it was read
+	condition, names, values := ownedVersionCondition(tenantId, song.GetMetadata().GetVersion())
+	err = d.transactWithEvents(ctx, tenantId, []types.TransactWriteItem{{
+		Put: &types.Put{
+			TableName: aws.String(SongsTable),
+			Item: item,
+			ConditionExpression: condition,
+			ExpressionAttributeNames: names,
+			ExpressionAttributeValues: values,
+		},
+	}}, events)
+	if isConditionFailure(err) {
+		logger.WithField("song", song).Warn("PutSong Repo: Version conflict")
+		return status.Error(codes.Aborted, "Song was modified by another request. Fetch it and try again")
+	}
 	if err != nil {
-		logger.WithField("song", song).Errorf("PutSong Repo: Could not PutItem: %s", err)
+		logger.WithField("song", song).Errorf("PutSong Repo: Could not write transaction: %s", err)
 		return status.Error(codes.Internal, "Failed to persist song")
 	}
 
@@ -151,44 +382,199 @@ func (d *DynamoRepository) PutSong(ctx context.Context, song *setmakerpb.Song) e
 }
 
 
-// Delete song
-func (d *DynamoRepository) DeleteSong(ctx context.Context, id uuid.UUID) error {
-	logger.WithField("id", id).Infof("DeleteSong Repo: Deleting song")
+// Put a set of new songs in transactions
+// events[i] is written in the same transaction as songs[i], up to SongsPerTransaction songs to a
+// transaction. Each transaction is atomic, but a failure part way through leaves earlier ones written
+func (d *DynamoRepository) PutSongs(ctx context.Context, songs []*setmakerpb.Song, events []*setmakerpb.Event) error {
+	tenantId, err := requireTenant(ctx, "PutSongs")
+	if err != nil {
+		return err
+	}
 
-	_, err := d.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
-		TableName: aws.String(SongsTable),
-		Key: map[string]types.AttributeValue{
-			"Id": &types.AttributeValueMemberS{Value: id.String()},
-		},
-	})
+	logger.WithField("count", len(songs)).Info("PutSongs Repo: Writing songs")
+
+	return d.transactSongs(ctx, "PutSongs", tenantId, songs, events)
+}
+
+
+// Put changes to a set of existing songs in transactions
+// Each song is written only if it is still at the version it was read at. events[i] is written
+// in the same transaction as songs[i]. Each batch is atomic, but a failure part way through
+// leaves earlier batches written
+func (d *DynamoRepository) UpdateSongs(ctx context.Context, songs []*setmakerpb.Song, events []*setmakerpb.Event) error {
+	tenantId, err := requireTenant(ctx, "UpdateSongs")
+	if err != nil {
+		return err
+	}
+
+	logger.WithField("count", len(songs)).Infof("UpdateSongs Repo: Writing songs")
+
+	return d.transactSongs(ctx, "UpdateSongs", tenantId, songs, events)
+}
+
+
+// write songs with their events, packing as many into each transaction as fit
+// each song is written only if it is new or the tenant's own and still at the version it was read at
+func (d *DynamoRepository) transactSongs(ctx context.Context, op string, tenantId string, songs []*setmakerpb.Song, events []*setmakerpb.Event) error {
+	var batch []types.TransactWriteItem
+	for i, song := range songs {
+		item, err := d.songItem(song, tenantId)
+		if err != nil {
+			logger.WithField("song", song).Errorf("%s Repo: Could not marshal map: %s", op, err)
+			return status.Error(codes.InvalidArgument, "Could not map input values for song")
+		}
+
+		condition, names, values := ownedVersionCondition(tenantId, song.GetMetadata().GetVersion())
+		group := []types.TransactWriteItem{{
+			Put: &types.Put{
+				TableName: aws.String(SongsTable),
+				Item: item,
+				ConditionExpression: condition,
+				ExpressionAttributeNames: names,
+				ExpressionAttributeValues: values,
+			},
+		}}
+
+		if i < len(events) {
+			puts, err := outboxPuts(events[i:i+1], tenantId)
+			if err != nil {
+				return status.Error(codes.Internal, "Could not build song events")
+			}
+			group = append(group, puts...)
+		}
+
+		// flush before a song and its events would be split across transactions
+		if len(batch)+len(group) > TransactWriteLimit {
+			if err := d.writeSongBatch(ctx, op, batch); err != nil {
+				return err
+			}
+			batch = nil
+		}
+		batch = append(batch, group...)
+	}
+
+	return d.writeSongBatch(ctx, op, batch)
+}
+
+
+func (d *DynamoRepository) writeSongBatch(ctx context.Context, op string, batch []types.TransactWriteItem) error {
+	err := d.transactWrite(ctx, batch)
+	if isConditionFailure(err) {
+		logger.Warnf("%s Repo: Version conflict", op)
+		return status.Error(codes.Aborted, "Songs were modified by another request. Try again")
+	}
 	if err != nil {
-		logger.WithField("id", id).Errorf("DeleteSong Repo: Could not delete song: %s", err)
-		return status.Error(codes.Internal, "Song could not be deleted")
+		logger.Errorf("%s Repo: Could not write songs: %s", op, err)
+		return status.Error(codes.Internal, "Failed to persist songs")
 	}
 
 	return nil
 }
 
 
-// build a paginated response of listed songs (by scan or by query)
-func (d *DynamoRepository) buildPaginatedResponse(items []map[string]types.AttributeValue, count int32, lek map[string]types.AttributeValue) (*SongList, error) {
-	// encode the return cursor
-	returnCursor, err := utils.EncodeAttributeMap(lek)
+// build the item for a tenant's song, including the attributes for the ordering and trash indexes
+// an expanded artist summary is only for responses, so is never stored
+func (d *DynamoRepository) songItem(song *setmakerpb.Song, tenantId string) (map[string]types.AttributeValue, error) {
+	item, err := attributevalue.MarshalMap(song)
+	if err != nil {
+		return nil, err
+	}
+	delete(item, "Artist")
+
+	addTenant(item, tenantId)
+	item["Catalog"] = &types.AttributeValueMemberS{Value: tenantKey(tenantId, SongCatalog)}
+	item["UpdatedAt"] = &types.AttributeValueMemberS{Value: song.GetMetadata().GetUpdatedAt()}
+	if err = d.addTrashAttributes(item, song.Metadata); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+
+// key condition for a song index, and the attribute it covers (if any)
+// the Catalog partition placeholder is added here, the others are added by songFilter
+func songKeyCondition(index string, tenantId string, values map[string]types.AttributeValue) (string, string) {
+	switch index {
+	case SongTitleIndex, SongUpdatedAtIndex:
+		values[":catalog"] = &types.AttributeValueMemberS{Value: tenantKey(tenantId, SongCatalog)}
+		return "Catalog = :catalog", ""
+	case SongArtistIndex:
+		return "ArtistId = :artistId", "ArtistId"
+	case SongKeyIndex:
+		return "#key = :key", "Key"
+	case TrashIndex:
+		return "#trash = :trash", "Trash"
+	}
+
+	return "", ""
+}
+
+
+// build the filter expression for a tenant's song query
+// the attribute covered by the index key condition (if any) is left out of the filter,
+// but its placeholders are still added for the key condition to use
+func songFilter(query SongQuery, tenantId string, indexed string, names map[string]string, values map[string]types.AttributeValue) *string {
+	// only the Catalog partition is scoped to the tenant, so every other index and the scan filter on it
+	names["#tenant"] = TenantAttribute
+	values[":tenant"] = &types.AttributeValueMemberS{Value: tenantId}
+	clauses := []string{"#tenant = :tenant"}
+
+	if query.Key != setmakerpb.Key_KEY_UNKNOWN {
+		// Key is a reserved word so needs an attribute name placeholder
+		names["#key"] = "Key"
+		values[":key"] = &types.AttributeValueMemberN{Value: strconv.Itoa(int(query.Key))}
+		if indexed != "Key" {
+			clauses = append(clauses, "#key = :key")
+		}
+	}
+
+	if query.Tonality != setmakerpb.Tonality_TONALITY_UNKNOWN {
+		values[":tonality"] = &types.AttributeValueMemberN{Value: strconv.Itoa(int(query.Tonality))}
+		clauses = append(clauses, "Tonality = :tonality")
+	}
+
+	if query.ArtistId != "" {
+		values[":artistId"] = &types.AttributeValueMemberS{Value: query.ArtistId}
+		if indexed != "ArtistId" {
+			clauses = append(clauses, "ArtistId = :artistId")
+		}
+	}
+
+	// live and deleted songs share the table and its indexes
+	names["#trash"] = "Trash"
+	if query.Deleted {
+		// dynamo rejects unused values, so :trash is only added for the trash index key condition
+		if indexed == "Trash" {
+			values[":trash"] = &types.AttributeValueMemberS{Value: TrashPartition}
+		} else {
+			clauses = append(clauses, "attribute_exists(#trash)")
+		}
+	} else {
+		clauses = append(clauses, "attribute_not_exists(#trash)")
+	}
+
+	return aws.String(strings.Join(clauses, " AND "))
+}
+
+
+// fill a page of songs (by scan or by query) and build the paginated response
+func (d *DynamoRepository) listSongs(ctx context.Context, shape utils.QueryShape, limit int32, cursor string, fetch pageFetcher) (*SongList, error) {
+	items, returnCursor, err := d.fillPage(ctx, shape, limit, cursor, fetch)
 	if err != nil {
-		logger.WithField("lastEvaluatedKey", lek).Errorf("buildPaginatedResponse Repo: Unable to encode attribute map: %s", err)
 		return nil, err
 	}
 
 	// parse results
 	var songs []*setmakerpb.Song
 	if err = attributevalue.UnmarshalListOfMaps(items, &songs); err != nil {
-		logger.Errorf("buildPaginatedResponse Repo: Could not unmarshal results: %s", err)
-		return nil, err
+		logger.Errorf("listSongs Repo: Could not unmarshal results: %s", err)
+		return nil, status.Error(codes.Internal, "Error unmarshaling song data")
 	}
 
 	return &SongList{
-		Count: count,
-		Cursor: string(returnCursor),
+		Count: int32(len(songs)),
+		Cursor: returnCursor,
 		Items: songs,
 	}, nil
 }
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+)
+
+var placeholder = regexp.MustCompile(`[:#][A-Za-z]+`)
+
+// dynamo rejects a query with an unused or undefined name or value placeholder,
+// so the key condition and filter together must use exactly the placeholders that were added
+func TestSongFilterUsesEveryPlaceholder(t *testing.T) {
+	artistId := "9b2c4f9e-6a57-4d5b-8f1a-0d1f2b3c4d5e"
+
+	tests := []struct {
+		name  string
+		query SongQuery
+		index string
+	}{
+		{"everything", SongQuery{}, ""},
+		{"by title", SongQuery{OrderBy: setmakerpb.SongOrder_SONG_ORDER_TITLE}, SongTitleIndex},
+		{"by artist", SongQuery{ArtistId: artistId}, SongArtistIndex},
+		{"by key", SongQuery{Key: setmakerpb.Key_KEY_D, Tonality: setmakerpb.Tonality_TONALITY_MINOR}, SongKeyIndex},
+		{"by artist and key", SongQuery{ArtistId: artistId, Key: setmakerpb.Key_KEY_D}, SongArtistIndex},
+		{"trash", SongQuery{Deleted: true}, TrashIndex},
+		{"artist's trash", SongQuery{ArtistId: artistId, Deleted: true}, SongArtistIndex},
+		{"trash by key", SongQuery{Key: setmakerpb.Key_KEY_D, Deleted: true}, SongKeyIndex},
+		{"trash by title", SongQuery{OrderBy: setmakerpb.SongOrder_SONG_ORDER_TITLE, Deleted: true}, SongTitleIndex},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index := tt.query.Index()
+			if index != tt.index {
+				t.Fatalf("Index() = %q, want %q", index, tt.index)
+			}
+
+			names := make(map[string]string)
+			values := make(map[string]types.AttributeValue)
+			keyCondition, indexed := songKeyCondition(index, "tenant", values)
+			filter := songFilter(tt.query, "tenant", indexed, names, values)
+
+			used := make(map[string]bool)
+			for _, p := range placeholder.FindAllString(keyCondition+" "+*filter, -1) {
+				used[p] = true
+			}
+
+			added := make(map[string]bool)
+			for name := range names {
+				added[name] = true
+			}
+			for value := range values {
+				added[value] = true
+			}
+
+			if got, want := sorted(added), sorted(used); !equal(got, want) {
+				t.Errorf("added placeholders %v, but %q / %q uses %v", got, keyCondition, *filter, want)
+			}
+		})
+	}
+}
+
+
+func TestSongFilterScopesToTheTenantAndTrash(t *testing.T) {
+	tests := []struct {
+		name  string
+		query SongQuery
+		want  string
+	}{
+		{"live", SongQuery{}, "#tenant = :tenant AND attribute_not_exists(#trash)"},
+		{"trash index", SongQuery{Deleted: true}, "#tenant = :tenant"},
+		{"artist's trash", SongQuery{ArtistId: "artist", Deleted: true}, "#tenant = :tenant AND attribute_exists(#trash)"},
+		{"live by key and tonality", SongQuery{Key: setmakerpb.Key_KEY_D, Tonality: setmakerpb.Tonality_TONALITY_MINOR}, "#tenant = :tenant AND Tonality = :tonality AND attribute_not_exists(#trash)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := make(map[string]types.AttributeValue)
+			_, indexed := songKeyCondition(tt.query.Index(), "tenant", values)
+			filter := songFilter(tt.query, "tenant", indexed, make(map[string]string), values)
+
+			if *filter != tt.want {
+				t.Errorf("songFilter() = %q, want %q", *filter, tt.want)
+			}
+		})
+	}
+}
+
+
+func sorted(set map[string]bool) []string {
+	res := make([]string, 0, len(set))
+	for s := range set {
+		res = append(res, s)
+	}
+	sort.Strings(res)
+
+	return res
+}
+
+
+func equal(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,233 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/pete-robinson/set-maker-grpc/internal/tenant"
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Every artist, song, setlist and artist name guard carries the TenantId of the tenant that owns it.
+// Reads drop items owned by other tenants and writes are conditioned on the stored item being the
+// tenant's own, so a tenant can't reach another tenant's data even by Id. The song Catalog partition
+// and the name guard keys are prefixed with the tenant, so ordering and name uniqueness are per tenant
+const TenantAttribute = "TenantId"
+
+
+// the tenant a repository call acts for
+func requireTenant(ctx context.Context, op string) (string, error) {
+	id, err := tenant.Require(ctx)
+	if err != nil {
+		logger.Errorf("%s Repo: Called without a tenant", op)
+	}
+
+	return id, err
+}
+
+
+// a key or partition value scoped to a tenant
+func tenantKey(tenantId string, value string) string {
+	return tenantId + "#" + value
+}
+
+
+// whether a fetched item belongs to a tenant
+func ownedBy(item map[string]types.AttributeValue, tenantId string) bool {
+	v, ok := item[TenantAttribute].(*types.AttributeValueMemberS)
+	return ok && v.Value == tenantId
+}
+
+
+// drop items belonging to other tenants from a set of fetched items
+func ownedItems(items []map[string]types.AttributeValue, tenantId string) []map[string]types.AttributeValue {
+	owned := items[:0]
+	for _, item := range items {
+		if ownedBy(item, tenantId) {
+			owned = append(owned, item)
+		}
+	}
+
+	return owned
+}
+
+
+// stamp an item with the tenant that owns it
+func addTenant(item map[string]types.AttributeValue, tenantId string) {
+	item[TenantAttribute] = &types.AttributeValueMemberS{Value: tenantId}
+}
+
+
+// extend a write condition so it also requires the stored item to be new or the tenant's own
+// names and values are added to in place; either may be nil
+func ownedCondition(tenantId string, condition *string, names map[string]string, values map[string]types.AttributeValue) (*string, map[string]string, map[string]types.AttributeValue) {
+	if names == nil {
+		names = make(map[string]string)
+	}
+	if values == nil {
+		values = make(map[string]types.AttributeValue)
+	}
+	names["#tenant"] = TenantAttribute
+	values[":tenant"] = &types.AttributeValueMemberS{Value: tenantId}
+
+	owned := "(attribute_not_exists(Id) OR #tenant = :tenant)"
+	if condition == nil {
+		return aws.String(owned), names, values
+	}
+
+	return aws.String(owned + " AND (" + *condition + ")"), names, values
+}
+
+
+// condition that the stored item is new or the tenant's own, and still at the version before this write
+func ownedVersionCondition(tenantId string, version int64) (*string, map[string]string, map[string]types.AttributeValue) {
+	condition, names, values := versionCondition(version)
+	return ownedCondition(tenantId, condition, names, values)
+}
+
+
+// Stamp artists, songs, setlists and artist name guards written before tenants were introduced
+// with a tenant, so an existing single-tenant deployment can be handed to its tenant.
+// Items that already have a tenant are left alone. Returns the number of items assigned
+func (d *DynamoRepository) AssignTenant(ctx context.Context, tenantId string) (int, error) {
+	if err := tenant.Validate(tenantId); err != nil {
+		return 0, err
+	}
+
+	assigned := 0
+	for _, table := range []string{ArtistsTable, SongsTable, SetlistsTable} {
+		count, err := d.assignTable(ctx, table, tenantId)
+		assigned += count
+		if err != nil {
+			return assigned, err
+		}
+	}
+
+	count, err := d.assignArtistNames(ctx, tenantId)
+	assigned += count
+	if err != nil {
+		return assigned, err
+	}
+
+	logger.WithFields(logger.Fields{
+		"tenant": tenantId,
+		"assigned": assigned,
+	}).Info("AssignTenant Repo: Items assigned to tenant")
+
+	return assigned, nil
+}
+
+
+// stamp the items of a table that have no tenant
+func (d *DynamoRepository) assignTable(ctx context.Context, table string, tenantId string) (int, error) {
+	assigned := 0
+	err := d.scanUnassigned(ctx, table, func(item map[string]types.AttributeValue) error {
+		addTenant(item, tenantId)
+		if table == SongsTable {
+			item["Catalog"] = &types.AttributeValueMemberS{Value: tenantKey(tenantId, SongCatalog)}
+		}
+
+		// skip items assigned or removed since the scan read them
+		_, err := d.client.PutItem(ctx, &dynamodb.PutItemInput{
+			TableName: aws.String(table),
+			Item: item,
+			ConditionExpression: aws.String("attribute_exists(Id) AND attribute_not_exists(#tenant)"),
+			ExpressionAttributeNames: map[string]string{"#tenant": TenantAttribute},
+		})
+		if isConditionFailure(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		assigned++
+		return nil
+	})
+	if err != nil {
+		logger.WithField("table", table).Errorf("AssignTenant Repo: Could not assign items: %s", err)
+		return assigned, status.Error(codes.Internal, "Could not assign items to tenant")
+	}
+
+	return assigned, nil
+}
+
+
+// move the name guards that have no tenant under the tenant's prefix
+func (d *DynamoRepository) assignArtistNames(ctx context.Context, tenantId string) (int, error) {
+	assigned := 0
+	err := d.scanUnassigned(ctx, ArtistNamesTable, func(item map[string]types.AttributeValue) error {
+		guard := struct {
+			Name     string
+			ArtistId string
+		}{}
+		if err := attributevalue.UnmarshalMap(item, &guard); err != nil {
+			return err
+		}
+
+		// add the scoped guard and drop the old one together
+		err := d.transactWrite(ctx, []types.TransactWriteItem{
+			{
+				Put: &types.Put{
+					TableName: aws.String(ArtistNamesTable),
+					Item: map[string]types.AttributeValue{
+						"Name": &types.AttributeValueMemberS{Value: tenantKey(tenantId, guard.Name)},
+						"ArtistId": &types.AttributeValueMemberS{Value: guard.ArtistId},
+						TenantAttribute: &types.AttributeValueMemberS{Value: tenantId},
+					},
+					ConditionExpression: aws.String("attribute_not_exists(#name)"),
+					ExpressionAttributeNames: map[string]string{"#name": "Name"},
+				},
+			},
+			releaseArtistName(guard.Name, guard.ArtistId),
+		})
+		if isConditionFailure(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		assigned++
+		return nil
+	})
+	if err != nil {
+		logger.Errorf("AssignTenant Repo: Could not assign artist names: %s", err)
+		return assigned, status.Error(codes.Internal, "Could not assign artist names to tenant")
+	}
+
+	return assigned, nil
+}
+
+
+// scan a table for items with no tenant, calling fn for each
+func (d *DynamoRepository) scanUnassigned(ctx context.Context, table string, fn func(map[string]types.AttributeValue) error) error {
+	var startKey map[string]types.AttributeValue
+	for {
+		res, err := d.client.Scan(ctx, &dynamodb.ScanInput{
+			TableName: aws.String(table),
+			FilterExpression: aws.String("attribute_not_exists(#tenant)"),
+			ExpressionAttributeNames: map[string]string{"#tenant": TenantAttribute},
+			ExclusiveStartKey: startKey,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, item := range res.Items {
+			if err = fn(item); err != nil {
+				return err
+			}
+		}
+
+		startKey = res.LastEvaluatedKey
+		if startKey == nil {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/pete-robinson/set-maker-grpc/internal/utils"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Deleted artists and songs stay in their tables with Metadata.DeletedAt set until they are purged.
+// They also carry a constant Trash attribute and an ExpiresAt epoch time in seconds. Trash and
+// ExpiresAt key the sparse Trash index on both tables, which holds only deleted items in the order
+// they expire. ExpiresAt is also the tables' TTL attribute, so DynamoDB removes expired items
+// by itself if the purge job isn't running. The index is shared by all tenants, so listings filter
+// on the tenant while the purge works across all of them
+const (
+	TrashIndex = "Trash-ExpiresAt-index"
+	TrashPartition = "trash"
+	// how long deleted items are kept when no retention is configured
+	DefaultTrashRetention = 30 * 24 * time.Hour
+)
+
+
+// Paginated list of the tenant's deleted artists, soonest to be purged first
+func (d *DynamoRepository) ListDeletedArtists(ctx context.Context, limit int32, cursor string) (*ArtistList, error) {
+	tenantId, err := requireTenant(ctx, "ListDeletedArtists")
+	if err != nil {
+		return nil, err
+	}
+
+	logger.WithFields(logger.Fields{
+		"limit": limit,
+		"cursor": cursor,
+		"tenant": tenantId,
+	}).Info("ListDeletedArtists Repo: Querying dynamo")
+
+	shape := utils.QueryShape{Tenant: tenantId, Table: ArtistsTable, Index: TrashIndex}
+	items, returnCursor, err := d.fillPage(ctx, shape, limit, cursor, d.trashFetcher(ArtistsTable, tenantId))
+	if err != nil {
+		return nil, err
+	}
+
+	var artists []*setmakerpb.Artist
+	if err = attributevalue.UnmarshalListOfMaps(items, &artists); err != nil {
+		logger.Errorf("ListDeletedArtists Repo: Could not unmarshal results: %s", err)
+		return nil, status.Error(codes.Internal, "Error unmarshaling artist data")
+	}
+
+	return &ArtistList{
+		Count: int32(len(artists)),
+		Cursor: returnCursor,
+		Items: artists,
+	}, nil
+}
+
+
+// Hard delete up to limit songs and up to limit artists whose retention expired before now
+// Nothing is written to the outbox - the delete events were raised when the items were trashed.
+// Returns the number of items removed
+func (d *DynamoRepository) PurgeTrash(ctx context.Context, now time.Time, limit int32) (int, error) {
+	purged := 0
+
+	// songs first, so an artist is never purged while its songs are still restorable
+	for _, table := range []string{SongsTable, ArtistsTable} {
+		res, err := d.client.Query(ctx, &dynamodb.QueryInput{
+			TableName: aws.String(table),
+			IndexName: aws.String(TrashIndex),
+			KeyConditionExpression: aws.String("#trash = :trash AND ExpiresAt <= :now"),
+			ExpressionAttributeNames: map[string]string{"#trash": "Trash"},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":trash": &types.AttributeValueMemberS{Value: TrashPartition},
+				":now": &types.AttributeValueMemberN{Value: strconv.FormatInt(now.Unix(), 10)},
+			},
+			ProjectionExpression: aws.String("Id"),
+			Limit: &limit,
+		})
+		if err != nil {
+			logger.WithField("table", table).Errorf("PurgeTrash Repo: Could not query expired items: %s", err)
+			return purged, status.Error(codes.Internal, "Could not list expired items")
+		}
+
+		for _, item := range res.Items {
+			// the index is eventually consistent, so make sure the item is still expired. one that has
+			// been restored or deleted again since is left alone
+			_, err = d.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+				TableName: aws.String(table),
+				Key: map[string]types.AttributeValue{"Id": item["Id"]},
+				ConditionExpression: aws.String("attribute_exists(#trash) AND ExpiresAt <= :now"),
+				ExpressionAttributeNames: map[string]string{"#trash": "Trash"},
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":now": &types.AttributeValueMemberN{Value: strconv.FormatInt(now.Unix(), 10)},
+				},
+			})
+			if isConditionFailure(err) {
+				continue
+			}
+			if err != nil {
+				logger.WithField("table", table).Errorf("PurgeTrash Repo: Could not delete expired item: %s", err)
+				return purged, status.Error(codes.Internal, "Could not purge expired items")
+			}
+			purged++
+		}
+	}
+
+	return purged, nil
+}
+
+
+// fetch pages of a tenant's items from a table's Trash index
+func (d *DynamoRepository) trashFetcher(table string, tenantId string) pageFetcher {
+	return func(ctx context.Context, startKey map[string]types.AttributeValue, limit int32) (*fetchedPage, error) {
+		res, err := d.client.Query(ctx, &dynamodb.QueryInput{
+			TableName: aws.String(table),
+			IndexName: aws.String(TrashIndex),
+			KeyConditionExpression: aws.String("#trash = :trash"),
+			FilterExpression: aws.String("#tenant = :tenant"),
+			ExpressionAttributeNames: map[string]string{
+				"#trash": "Trash",
+				"#tenant": TenantAttribute,
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":trash": &types.AttributeValueMemberS{Value: TrashPartition},
+				":tenant": &types.AttributeValueMemberS{Value: tenantId},
+			},
+			Limit: &limit,
+			ExclusiveStartKey: startKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &fetchedPage{items: res.Items, lastEvaluatedKey: res.LastEvaluatedKey}, nil
+	}
+}
+
+
+// add the trash attributes to the item of a deleted artist or song, expiring retention after its DeletedAt
+// live items are left as they are, so writing one back drops the attributes and takes it out of the trash
+func (d *DynamoRepository) addTrashAttributes(item map[string]types.AttributeValue, meta *setmakerpb.Metadata) error {
+	if meta.GetDeletedAt() == "" {
+		return nil
+	}
+
+	expiresAt, err := utils.TrashExpiry(meta, d.retention)
+	if err != nil {
+		return err
+	}
+
+	item["Trash"] = &types.AttributeValueMemberS{Value: TrashPartition}
+	item["ExpiresAt"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(expiresAt, 10)}
+	return nil
+}
+
+
+// whether a fetched item is a deleted artist or song
+func isTrashed(item map[string]types.AttributeValue) bool {
+	_, ok := item["Trash"]
+	return ok
+}
+
+
+// drop deleted artists or songs from a set of fetched items
+func withoutTrashed(items []map[string]types.AttributeValue) []map[string]types.AttributeValue {
+	live := items[:0]
+	for _, item := range items {
+		if !isTrashed(item) {
+			live = append(live, item)
+		}
+	}
+
+	return live
+}
@@ -0,0 +1,164 @@
+package memory
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	repository "github.com/pete-robinson/set-maker-grpc/internal/repository/ddb"
+	"github.com/pete-robinson/set-maker-grpc/internal/utils"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// In-memory implementation of service.Repository
+// Mirrors the behaviour of the DynamoDB repository (errors, cursors, index and tenant semantics)
+// so the server can run locally and the service layer can be exercised without AWS
+type MemoryRepository struct {
+	mu       sync.RWMutex
+	artists  map[string]*setmakerpb.Artist
+	songs    map[string]*setmakerpb.Song
+	setlists map[string]*setmakerpb.Setlist
+	outbox   map[string]*repository.OutboxEntry
+	// tenant of each artist, song and setlist, by Id
+	tenants map[string]string
+	// tenant scoped normalised artist name to artist ID
+	names map[string]string
+	// expiry times, in epoch seconds, of deleted artists and songs
+	expires   map[string]int64
+	retention time.Duration
+	cursors   *utils.CursorCodec
+}
+
+
+func NewMemoryRepository(cursors *utils.CursorCodec, retention time.Duration) *MemoryRepository {
+	return &MemoryRepository{
+		cursors:   cursors,
+		artists:   make(map[string]*setmakerpb.Artist),
+		songs:     make(map[string]*setmakerpb.Song),
+		setlists:  make(map[string]*setmakerpb.Setlist),
+		outbox:    make(map[string]*repository.OutboxEntry),
+		tenants:   make(map[string]string),
+		names:     make(map[string]string),
+		expires:   make(map[string]int64),
+		retention: retention,
+	}
+}
+
+
+// most lists are ordered by the table key
+var idOrder = []string{"Id"}
+
+
+// options for a paginated listing
+// order lists the attributes items are sorted on, most significant first, and must end with a unique attribute
+type listing struct {
+	shape      utils.QueryShape
+	order      []string
+	descending bool
+	limit      int32
+	cursor     string
+}
+
+
+// paginate a slice of items ordered by their key attributes
+// cursors are encoded the same way as the DynamoDB repository's so clients see no difference.
+// keyOf returns the key attributes of an item - the table key plus any index key being queried
+func paginate[T proto.Message](cursors *utils.CursorCodec, items []T, keyOf func(T) map[string]string, l listing) ([]T, int32, string, error) {
+	order, descending, limit := l.order, l.descending, l.limit
+	sort.Slice(items, func(i, j int) bool {
+		return compareKeys(keyOf(items[i]), keyOf(items[j]), order, descending) < 0
+	})
+
+	// decode the cursor and skip everything up to and including the last evaluated key
+	c, err := cursors.Decode(l.shape, l.cursor)
+	if errors.Is(err, utils.ErrCursorMismatch) {
+		return nil, 0, "", status.Error(codes.InvalidArgument, "Cursor does not belong to this query")
+	}
+	if err != nil {
+		return nil, 0, "", status.Error(codes.InvalidArgument, "Invalid cursor")
+	}
+
+	if limit < 1 {
+		return nil, 0, "", status.Error(codes.InvalidArgument, "Invalid page size")
+	}
+
+	start := 0
+	if c != nil {
+		last := make(map[string]string)
+		for _, attr := range order {
+			v, ok := c[attr].(*types.AttributeValueMemberS)
+			if !ok {
+				return nil, 0, "", status.Error(codes.InvalidArgument, "Invalid cursor")
+			}
+			last[attr] = v.Value
+		}
+
+		start = sort.Search(len(items), func(i int) bool {
+			return compareKeys(keyOf(items[i]), last, order, descending) > 0
+		})
+	}
+
+	end := len(items)
+	if start+int(limit) < end {
+		end = start + int(limit)
+	}
+
+	page := make([]T, 0, end-start)
+	for _, item := range items[start:end] {
+		page = append(page, proto.Clone(item).(T))
+	}
+
+	// only return a cursor when there are more items to fetch
+	returnCursor := ""
+	if end < len(items) && len(page) > 0 {
+		key := make(map[string]types.AttributeValue)
+		for k, v := range keyOf(page[len(page)-1]) {
+			key[k] = &types.AttributeValueMemberS{Value: v}
+		}
+
+		returnCursor, err = cursors.Encode(l.shape, key)
+		if err != nil {
+			return nil, 0, "", status.Error(codes.Internal, "Could not encode cursor")
+		}
+	}
+
+	return page, int32(len(page)), returnCursor, nil
+}
+
+
+// compare two sets of key attributes on the given order
+func compareKeys(a map[string]string, b map[string]string, order []string, descending bool) int {
+	for _, attr := range order {
+		c := strings.Compare(a[attr], b[attr])
+		if c == 0 {
+			continue
+		}
+		if descending {
+			return -c
+		}
+		return c
+	}
+
+	return 0
+}
+
+
+// emulate the DynamoDB version condition: the stored item must be at the version before this write
+func checkVersion(stored *setmakerpb.Metadata, next *setmakerpb.Metadata) error {
+	expected := next.GetVersion() - 1
+	if expected < 0 {
+		expected = 0
+	}
+
+	if stored.GetVersion() != expected {
+		return status.Error(codes.Aborted, "Item was modified by another request. Fetch it and try again")
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	repository "github.com/pete-robinson/set-maker-grpc/internal/repository/ddb"
+	"github.com/pete-robinson/set-maker-grpc/internal/tenant"
+	"github.com/pete-robinson/set-maker-grpc/internal/utils"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+
+func (m *MemoryRepository) ListArtists(ctx context.Context, limit int32, cursor string) (*repository.ArtistList, error) {
+	tenantId, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	items := make([]*setmakerpb.Artist, 0, len(m.artists))
+	total := int64(0)
+	for _, artist := range m.artists {
+		if !m.owns(tenantId, artist.Id) {
+			continue
+		}
+		if !isDeleted(artist.Metadata) {
+			total++
+			items = append(items, artist)
+		}
+	}
+	m.mu.RUnlock()
+
+	page, count, returnCursor, err := paginate(m.cursors, items, artistKey, listing{
+		shape: utils.QueryShape{Tenant: tenantId, Table: repository.ArtistsTable},
+		order: idOrder,
+		limit: limit,
+		cursor: cursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &repository.ArtistList{
+		Count: count,
+		Cursor: returnCursor,
+		Items: page,
+		TotalEstimate: total,
+	}, nil
+}
+
+
+// Get a set of artists by Id, keyed by Id
+func (m *MemoryRepository) BatchGetArtists(ctx context.Context, ids []uuid.UUID) (map[string]*setmakerpb.Artist, error) {
+	tenantId, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	res := make(map[string]*setmakerpb.Artist, len(ids))
+	for _, id := range ids {
+		if artist, ok := m.artists[id.String()]; ok && m.owns(tenantId, artist.Id) && !isDeleted(artist.Metadata) {
+			res[artist.Id] = proto.Clone(artist).(*setmakerpb.Artist)
+		}
+	}
+
+	return res, nil
+}
+
+
+// Get artist by Id. deleted artists are not found
+func (m *MemoryRepository) GetArtist(ctx context.Context, id uuid.UUID) (*setmakerpb.Artist, error) {
+	return m.getArtist(ctx, id, false)
+}
+
+
+// Get a deleted artist by Id. live artists are not found
+func (m *MemoryRepository) GetDeletedArtist(ctx context.Context, id uuid.UUID) (*setmakerpb.Artist, error) {
+	return m.getArtist(ctx, id, true)
+}
+
+
+func (m *MemoryRepository) getArtist(ctx context.Context, id uuid.UUID, deleted bool) (*setmakerpb.Artist, error) {
+	tenantId, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	artist, ok := m.artists[id.String()]
+	if !ok || !m.owns(tenantId, artist.Id) || isDeleted(artist.Metadata) != deleted {
+		return nil, status.Error(codes.NotFound, "Artist not found")
+	}
+
+	return proto.Clone(artist).(*setmakerpb.Artist), nil
+}
+
+
+// Get the tenant's artist by case-insensitive name
+func (m *MemoryRepository) GetArtistByName(ctx context.Context, name string) (*setmakerpb.Artist, error) {
+	tenantId, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	id, ok := m.names[nameKey(tenantId, name)]
+	m.mu.RUnlock()
+
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Artist not found")
+	}
+
+	return m.GetArtist(ctx, uuid.MustParse(id))
+}
+
+
+// Put artist, reserving its normalised name within the tenant
+// a deleted artist releases its name instead
+func (m *MemoryRepository) PutArtist(ctx context.Context, artist *setmakerpb.Artist, events ...*setmakerpb.Event) error {
+	tenantId, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored := m.artists[artist.Id]
+	if err := m.checkOwnedVersion(tenantId, artist.Id, stored.GetMetadata(), artist.Metadata); err != nil {
+		return err
+	}
+
+	expiresAt, err := m.expiry(artist.Metadata)
+	if err != nil {
+		return err
+	}
+
+	deleted := isDeleted(artist.Metadata)
+	name := nameKey(tenantId, artist.Name)
+	if owner, ok := m.names[name]; ok && owner != artist.Id && !deleted {
+		return status.Error(codes.AlreadyExists, "An artist with this name already exists")
+	}
+
+	if err := m.appendOutbox(events, tenantId); err != nil {
+		return err
+	}
+
+	if stored != nil && m.names[nameKey(tenantId, stored.Name)] == artist.Id {
+		delete(m.names, nameKey(tenantId, stored.Name))
+	}
+	if !deleted {
+		m.names[name] = artist.Id
+	}
+	m.tenants[artist.Id] = tenantId
+	m.setExpiry(artist.Id, expiresAt)
+	m.artists[artist.Id] = proto.Clone(artist).(*setmakerpb.Artist)
+	return nil
+}
+
+
+func artistKey(a *setmakerpb.Artist) map[string]string {
+	return map[string]string{"Id": a.Id}
+}
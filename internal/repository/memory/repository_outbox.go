@@ -0,0 +1,94 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	repository "github.com/pete-robinson/set-maker-grpc/internal/repository/ddb"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+
+// Pending outbox entries that are due to be published, longest due first
+func (m *MemoryRepository) ListPendingOutbox(ctx context.Context, limit int32) ([]*repository.OutboxEntry, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	now := time.Now().UnixMilli()
+	var entries []*repository.OutboxEntry
+	for _, entry := range m.outbox {
+		if entry.Status == repository.OutboxPending && entry.NextAttemptAt <= now {
+			e := *entry
+			entries = append(entries, &e)
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].NextAttemptAt < entries[j].NextAttemptAt
+	})
+
+	if limit > 0 && int(limit) < len(entries) {
+		entries = entries[:limit]
+	}
+
+	return entries, nil
+}
+
+
+// Mark an entry as published, to expire after repository.OutboxSentRetention
+// sent entries that have expired are dropped, standing in for the DynamoDB TTL
+func (m *MemoryRepository) MarkOutboxSent(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, ok := m.outbox[id]
+	if !ok || entry.Status != repository.OutboxPending {
+		return status.Error(codes.Internal, "Failed to mark outbox entry sent")
+	}
+
+	now := time.Now()
+	entry.Status = repository.OutboxSent
+	entry.SentAt = now.UTC().Format(time.RFC3339Nano)
+	entry.ExpiresAt = now.Add(repository.OutboxSentRetention).Unix()
+
+	for key, sent := range m.outbox {
+		if sent.Status == repository.OutboxSent && sent.ExpiresAt <= now.Unix() {
+			delete(m.outbox, key)
+		}
+	}
+
+	return nil
+}
+
+
+// Record a failed publish attempt, unless the entry is no longer pending
+func (m *MemoryRepository) RescheduleOutbox(ctx context.Context, entry *repository.OutboxEntry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if stored, ok := m.outbox[entry.Id]; ok && stored.Status != repository.OutboxPending {
+		return nil
+	}
+
+	e := *entry
+	m.outbox[entry.Id] = &e
+	return nil
+}
+
+
+// add a tenant's events to the outbox. callers must hold the write lock
+func (m *MemoryRepository) appendOutbox(events []*setmakerpb.Event, tenantId string) error {
+	for _, event := range events {
+		event.TenantId = tenantId
+		entry, err := repository.NewOutboxEntry(event)
+		if err != nil {
+			return status.Error(codes.Internal, "Could not build outbox entry")
+		}
+		m.outbox[entry.Id] = entry
+	}
+
+	return nil
+}
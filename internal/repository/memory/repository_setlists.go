@@ -0,0 +1,112 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	repository "github.com/pete-robinson/set-maker-grpc/internal/repository/ddb"
+	"github.com/pete-robinson/set-maker-grpc/internal/tenant"
+	"github.com/pete-robinson/set-maker-grpc/internal/utils"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+
+// Paginated list of the tenant's setlists
+func (m *MemoryRepository) ListSetlists(ctx context.Context, limit int32, cursor string) (*repository.SetlistList, error) {
+	tenantId, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	items := make([]*setmakerpb.Setlist, 0, len(m.setlists))
+	for _, setlist := range m.setlists {
+		if m.owns(tenantId, setlist.Id) {
+			items = append(items, setlist)
+		}
+	}
+	m.mu.RUnlock()
+
+	page, count, returnCursor, err := paginate(m.cursors, items, setlistKey, listing{
+		shape: utils.QueryShape{Tenant: tenantId, Table: repository.SetlistsTable},
+		order: idOrder,
+		limit: limit,
+		cursor: cursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &repository.SetlistList{
+		Count: count,
+		Cursor: returnCursor,
+		Items: page,
+	}, nil
+}
+
+
+// Get setlist by Id
+func (m *MemoryRepository) GetSetlist(ctx context.Context, id uuid.UUID) (*setmakerpb.Setlist, error) {
+	tenantId, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	setlist, ok := m.setlists[id.String()]
+	if !ok || !m.owns(tenantId, setlist.Id) {
+		return nil, status.Error(codes.NotFound, "Setlist not found")
+	}
+
+	return proto.Clone(setlist).(*setmakerpb.Setlist), nil
+}
+
+
+// Put setlist
+func (m *MemoryRepository) PutSetlist(ctx context.Context, setlist *setmakerpb.Setlist) error {
+	tenantId, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.checkOwnedVersion(tenantId, setlist.Id, m.setlists[setlist.Id].GetMetadata(), setlist.Metadata); err != nil {
+		return err
+	}
+
+	m.tenants[setlist.Id] = tenantId
+	m.setlists[setlist.Id] = proto.Clone(setlist).(*setmakerpb.Setlist)
+	return nil
+}
+
+
+// Delete setlist
+func (m *MemoryRepository) DeleteSetlist(ctx context.Context, id uuid.UUID) error {
+	tenantId, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.ownsOrAbsent(tenantId, id.String()) {
+		return status.Error(codes.NotFound, "Setlist not found")
+	}
+
+	delete(m.setlists, id.String())
+	delete(m.tenants, id.String())
+	return nil
+}
+
+
+func setlistKey(s *setmakerpb.Setlist) map[string]string {
+	return map[string]string{"Id": s.Id}
+}
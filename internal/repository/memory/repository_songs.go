@@ -0,0 +1,287 @@
+package memory
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/google/uuid"
+	repository "github.com/pete-robinson/set-maker-grpc/internal/repository/ddb"
+	"github.com/pete-robinson/set-maker-grpc/internal/tenant"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+
+// Paginated list of songs matching a query
+// Picks the same index as the DynamoDB repository so cursors carry the same attributes
+func (m *MemoryRepository) ListSongs(ctx context.Context, limit int32, cursor string, query repository.SongQuery) (*repository.SongList, error) {
+	tenantId, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	items := make([]*setmakerpb.Song, 0, len(m.songs))
+	total := int64(0)
+	for _, song := range m.songs {
+		if !m.owns(tenantId, song.Id) {
+			continue
+		}
+		// the estimate is of the live catalog, so trash listings go without one
+		if !query.Deleted && !isDeleted(song.Metadata) {
+			total++
+		}
+		if matchesSongQuery(song, query) {
+			items = append(items, song)
+		}
+	}
+	m.mu.RUnlock()
+
+	keyOf, order := songKey, idOrder
+	switch query.Index() {
+	case repository.SongTitleIndex:
+		keyOf, order = songTitleIndexKey, []string{"Title", "Id"}
+	case repository.SongUpdatedAtIndex:
+		keyOf, order = songUpdatedAtIndexKey, []string{"UpdatedAt", "Id"}
+	case repository.SongArtistIndex:
+		keyOf = songIndexKey
+	case repository.SongKeyIndex:
+		keyOf = songKeyIndexKey
+	case repository.TrashIndex:
+		keyOf, order = m.songTrashKey, trashOrder
+	}
+
+	shape := query.Shape()
+	shape.Tenant = tenantId
+
+	page, count, returnCursor, err := paginate(m.cursors, items, keyOf, listing{
+		shape: shape,
+		order: order,
+		descending: query.Descending,
+		limit: limit,
+		cursor: cursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &repository.SongList{
+		Count: count,
+		Cursor: returnCursor,
+		Items: page,
+		TotalEstimate: total,
+	}, nil
+}
+
+
+// Paginated list of songs by artistId
+// Behaves like a query against the ArtistId-index: only exact artist matches are returned
+// and cursors carry both the index key and the table key
+func (m *MemoryRepository) ListSongsByArtist(ctx context.Context, limit int32, cursor string, artistId string) (*repository.SongList, error) {
+	return m.ListSongs(ctx, limit, cursor, repository.SongQuery{ArtistId: artistId})
+}
+
+
+// Paginated list of songs in a key and tonality
+// Behaves like a query against the Key-index with a tonality filter
+func (m *MemoryRepository) ListSongsByKey(ctx context.Context, limit int32, cursor string, key setmakerpb.Key, tonality setmakerpb.Tonality) (*repository.SongList, error) {
+	return m.ListSongs(ctx, limit, cursor, repository.SongQuery{Key: key, Tonality: tonality})
+}
+
+
+// Get song by Id. deleted songs are not found
+func (m *MemoryRepository) GetSong(ctx context.Context, id uuid.UUID) (*setmakerpb.Song, error) {
+	return m.getSong(ctx, id, false)
+}
+
+
+// Get a deleted song by Id. live songs are not found
+func (m *MemoryRepository) GetDeletedSong(ctx context.Context, id uuid.UUID) (*setmakerpb.Song, error) {
+	return m.getSong(ctx, id, true)
+}
+
+
+func (m *MemoryRepository) getSong(ctx context.Context, id uuid.UUID, deleted bool) (*setmakerpb.Song, error) {
+	tenantId, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	song, ok := m.songs[id.String()]
+	if !ok || !m.owns(tenantId, song.Id) || isDeleted(song.Metadata) != deleted {
+		return nil, status.Error(codes.NotFound, "Song not found")
+	}
+
+	return proto.Clone(song).(*setmakerpb.Song), nil
+}
+
+
+// Get a set of songs by Id, keyed by Id
+func (m *MemoryRepository) BatchGetSongs(ctx context.Context, ids []uuid.UUID) (map[string]*setmakerpb.Song, error) {
+	tenantId, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	res := make(map[string]*setmakerpb.Song, len(ids))
+	for _, id := range ids {
+		if song, ok := m.songs[id.String()]; ok && m.owns(tenantId, song.Id) && !isDeleted(song.Metadata) {
+			res[song.Id] = proto.Clone(song).(*setmakerpb.Song)
+		}
+	}
+
+	return res, nil
+}
+
+
+// Put Song
+func (m *MemoryRepository) PutSong(ctx context.Context, song *setmakerpb.Song, events ...*setmakerpb.Event) error {
+	tenantId, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.checkOwnedVersion(tenantId, song.Id, m.songs[song.Id].GetMetadata(), song.Metadata); err != nil {
+		return err
+	}
+
+	expiresAt, err := m.expiry(song.Metadata)
+	if err != nil {
+		return err
+	}
+
+	if err := m.appendOutbox(events, tenantId); err != nil {
+		return err
+	}
+
+	m.tenants[song.Id] = tenantId
+	m.setExpiry(song.Id, expiresAt)
+	m.songs[song.Id] = storedSong(song)
+	return nil
+}
+
+
+// Put a set of new songs
+// like dynamo, a song that already exists is only overwritten if it is the tenant's and at the version before
+func (m *MemoryRepository) PutSongs(ctx context.Context, songs []*setmakerpb.Song, events []*setmakerpb.Event) error {
+	tenantId, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, song := range songs {
+		if err := m.checkOwnedVersion(tenantId, song.Id, m.songs[song.Id].GetMetadata(), song.Metadata); err != nil {
+			return err
+		}
+	}
+
+	if err := m.appendOutbox(events, tenantId); err != nil {
+		return err
+	}
+
+	for _, song := range songs {
+		m.tenants[song.Id] = tenantId
+		m.songs[song.Id] = storedSong(song)
+	}
+	return nil
+}
+
+
+// Put changes to a set of existing songs
+// every song is version checked before any is written
+func (m *MemoryRepository) UpdateSongs(ctx context.Context, songs []*setmakerpb.Song, events []*setmakerpb.Event) error {
+	tenantId, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	expires := make([]int64, len(songs))
+	for i, song := range songs {
+		if err := m.checkOwnedVersion(tenantId, song.Id, m.songs[song.Id].GetMetadata(), song.Metadata); err != nil {
+			return err
+		}
+		if expires[i], err = m.expiry(song.Metadata); err != nil {
+			return err
+		}
+	}
+
+	if err := m.appendOutbox(events, tenantId); err != nil {
+		return err
+	}
+
+	for i, song := range songs {
+		m.tenants[song.Id] = tenantId
+		m.setExpiry(song.Id, expires[i])
+		m.songs[song.Id] = storedSong(song)
+	}
+	return nil
+}
+
+
+// copy of a song as it would be stored, without an expanded artist summary
+func storedSong(song *setmakerpb.Song) *setmakerpb.Song {
+	stored := proto.Clone(song).(*setmakerpb.Song)
+	stored.Artist = nil
+	return stored
+}
+
+
+// apply the filters of a song query. Unset fields match every live song
+func matchesSongQuery(song *setmakerpb.Song, query repository.SongQuery) bool {
+	if isDeleted(song.Metadata) != query.Deleted {
+		return false
+	}
+	if query.Key != setmakerpb.Key_KEY_UNKNOWN && song.Key != query.Key {
+		return false
+	}
+	if query.Tonality != setmakerpb.Tonality_TONALITY_UNKNOWN && song.Tonality != query.Tonality {
+		return false
+	}
+	if query.ArtistId != "" && song.ArtistId != query.ArtistId {
+		return false
+	}
+
+	return query.CreatedInRange(song.GetMetadata().GetCreatedAt())
+}
+
+
+func songKey(s *setmakerpb.Song) map[string]string {
+	return map[string]string{"Id": s.Id}
+}
+
+
+func songIndexKey(s *setmakerpb.Song) map[string]string {
+	return map[string]string{"Id": s.Id, "ArtistId": s.ArtistId}
+}
+
+
+func songKeyIndexKey(s *setmakerpb.Song) map[string]string {
+	return map[string]string{"Id": s.Id, "Key": strconv.Itoa(int(s.Key))}
+}
+
+
+func songTitleIndexKey(s *setmakerpb.Song) map[string]string {
+	return map[string]string{"Id": s.Id, "Catalog": repository.SongCatalog, "Title": s.Title}
+}
+
+
+func songUpdatedAtIndexKey(s *setmakerpb.Song) map[string]string {
+	return map[string]string{"Id": s.Id, "Catalog": repository.SongCatalog, "UpdatedAt": s.GetMetadata().GetUpdatedAt()}
+}
@@ -0,0 +1,38 @@
+package memory
+
+import (
+	"github.com/pete-robinson/set-maker-grpc/internal/utils"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+
+// whether an item belongs to a tenant. callers must hold the lock
+func (m *MemoryRepository) owns(tenantId string, id string) bool {
+	return m.tenants[id] == tenantId
+}
+
+
+// whether an Id is free or already the tenant's. callers must hold the lock
+func (m *MemoryRepository) ownsOrAbsent(tenantId string, id string) bool {
+	owner, ok := m.tenants[id]
+	return !ok || owner == tenantId
+}
+
+
+// emulate the DynamoDB write condition: the stored item must be new or the tenant's own,
+// and at the version before this write. callers must hold the lock
+func (m *MemoryRepository) checkOwnedVersion(tenantId string, id string, stored *setmakerpb.Metadata, next *setmakerpb.Metadata) error {
+	if !m.ownsOrAbsent(tenantId, id) {
+		return status.Error(codes.Aborted, "Item was modified by another request. Fetch it and try again")
+	}
+
+	return checkVersion(stored, next)
+}
+
+
+// the key reserving an artist name within a tenant
+func nameKey(tenantId string, name string) string {
+	return tenantId + "#" + utils.NormaliseName(name)
+}
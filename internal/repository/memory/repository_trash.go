@@ -0,0 +1,139 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	repository "github.com/pete-robinson/set-maker-grpc/internal/repository/ddb"
+	"github.com/pete-robinson/set-maker-grpc/internal/tenant"
+	"github.com/pete-robinson/set-maker-grpc/internal/utils"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// deleted items are ordered by expiry and then Id, like the Trash index
+var trashOrder = []string{"ExpiresAt", "Id"}
+
+
+// Paginated list of the tenant's deleted artists, soonest to be purged first
+func (m *MemoryRepository) ListDeletedArtists(ctx context.Context, limit int32, cursor string) (*repository.ArtistList, error) {
+	tenantId, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	items := make([]*setmakerpb.Artist, 0)
+	for _, artist := range m.artists {
+		if m.owns(tenantId, artist.Id) && isDeleted(artist.Metadata) {
+			items = append(items, artist)
+		}
+	}
+	m.mu.RUnlock()
+
+	page, count, returnCursor, err := paginate(m.cursors, items, m.artistTrashKey, listing{
+		shape: utils.QueryShape{Tenant: tenantId, Table: repository.ArtistsTable, Index: repository.TrashIndex},
+		order: trashOrder,
+		limit: limit,
+		cursor: cursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &repository.ArtistList{
+		Count: count,
+		Cursor: returnCursor,
+		Items: page,
+	}, nil
+}
+
+
+// Hard delete up to limit songs and up to limit artists whose retention expired before now, across all tenants
+func (m *MemoryRepository) PurgeTrash(ctx context.Context, now time.Time, limit int32) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	purged := 0
+	expired := func(id string) bool {
+		expires, ok := m.expires[id]
+		return ok && expires <= now.Unix()
+	}
+
+	count := int32(0)
+	for id := range m.songs {
+		if count < limit && expired(id) {
+			delete(m.songs, id)
+			delete(m.expires, id)
+			delete(m.tenants, id)
+			count++
+		}
+	}
+	purged += int(count)
+
+	count = 0
+	for id := range m.artists {
+		if count < limit && expired(id) {
+			delete(m.artists, id)
+			delete(m.expires, id)
+			delete(m.tenants, id)
+			count++
+		}
+	}
+	purged += int(count)
+
+	return purged, nil
+}
+
+
+// the expiry of an item being written, retention after its DeletedAt, or 0 if it isn't deleted
+func (m *MemoryRepository) expiry(meta *setmakerpb.Metadata) (int64, error) {
+	expiresAt, err := utils.TrashExpiry(meta, m.retention)
+	if err != nil {
+		return 0, status.Error(codes.InvalidArgument, "Invalid DeletedAt")
+	}
+
+	return expiresAt, nil
+}
+
+
+// record or clear the expiry of an item being written. an expiry of 0 takes it out of the trash
+// must be called with the write lock held
+func (m *MemoryRepository) setExpiry(id string, expiresAt int64) {
+	if expiresAt == 0 {
+		delete(m.expires, id)
+		return
+	}
+
+	m.expires[id] = expiresAt
+}
+
+
+func (m *MemoryRepository) artistTrashKey(a *setmakerpb.Artist) map[string]string {
+	return m.trashKey(a.Id)
+}
+
+
+func (m *MemoryRepository) songTrashKey(s *setmakerpb.Song) map[string]string {
+	return m.trashKey(s.Id)
+}
+
+
+// expiry is zero padded so it sorts as a string
+func (m *MemoryRepository) trashKey(id string) map[string]string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return map[string]string{
+		"Id": id,
+		"Trash": repository.TrashPartition,
+		"ExpiresAt": fmt.Sprintf("%020d", m.expires[id]),
+	}
+}
+
+
+func isDeleted(meta *setmakerpb.Metadata) bool {
+	return meta.GetDeletedAt() != ""
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+)
+
+
+func newArtistCreatedEvent(artist *setmakerpb.Artist) *setmakerpb.Event {
+	return &setmakerpb.Event{
+		EventType: setmakerpb.Event_EVENT_ARTIST_CREATED,
+		MessageBody: &setmakerpb.Event_ArtistCreated{
+			ArtistCreated: &setmakerpb.MessageBody_ArtistCreated{
+				Id:   artist.Id,
+				Name: artist.Name,
+			},
+		},
+	}
+}
+
+
+func newArtistUpdatedEvent(before *setmakerpb.Artist, after *setmakerpb.Artist) *setmakerpb.Event {
+	return &setmakerpb.Event{
+		EventType: setmakerpb.Event_EVENT_ARTIST_UPDATED,
+		MessageBody: &setmakerpb.Event_ArtistUpdated{
+			ArtistUpdated: &setmakerpb.MessageBody_ArtistUpdated{
+				Id:     after.Id,
+				Before: before,
+				After:  after,
+			},
+		},
+	}
+}
+
+
+func newArtistDeletedEvent(artist *setmakerpb.Artist) *setmakerpb.Event {
+	return &setmakerpb.Event{
+		EventType: setmakerpb.Event_EVENT_ARTIST_DELETED,
+		MessageBody: &setmakerpb.Event_ArtistDeleted{
+			ArtistDeleted: &setmakerpb.MessageBody_ArtistDeleted{
+				Id:     artist.Id,
+				Artist: artist,
+			},
+		},
+	}
+}
+
+
+func newSongCreatedEvent(song *setmakerpb.Song) *setmakerpb.Event {
+	return &setmakerpb.Event{
+		EventType: setmakerpb.Event_EVENT_SONG_CREATED,
+		MessageBody: &setmakerpb.Event_SongCreated{
+			SongCreated: &setmakerpb.MessageBody_SongCreated{
+				Id:   song.Id,
+				Song: song,
+			},
+		},
+	}
+}
+
+
+func newSongUpdatedEvent(before *setmakerpb.Song, after *setmakerpb.Song) *setmakerpb.Event {
+	return &setmakerpb.Event{
+		EventType: setmakerpb.Event_EVENT_SONG_UPDATED,
+		MessageBody: &setmakerpb.Event_SongUpdated{
+			SongUpdated: &setmakerpb.MessageBody_SongUpdated{
+				Id:     after.Id,
+				Before: before,
+				After:  after,
+			},
+		},
+	}
+}
+
+
+func newSongDeletedEvent(song *setmakerpb.Song) *setmakerpb.Event {
+	return &setmakerpb.Event{
+		EventType: setmakerpb.Event_EVENT_SONG_DELETED,
+		MessageBody: &setmakerpb.Event_SongDeleted{
+			SongDeleted: &setmakerpb.MessageBody_SongDeleted{
+				Id:   song.Id,
+				Song: song,
+			},
+		},
+	}
+}
+
+
+func newArtistRestoredEvent(artist *setmakerpb.Artist) *setmakerpb.Event {
+	return &setmakerpb.Event{
+		EventType: setmakerpb.Event_EVENT_ARTIST_RESTORED,
+		MessageBody: &setmakerpb.Event_ArtistRestored{
+			ArtistRestored: &setmakerpb.MessageBody_ArtistRestored{
+				Id:     artist.Id,
+				Artist: artist,
+			},
+		},
+	}
+}
+
+
+func newSongRestoredEvent(song *setmakerpb.Song) *setmakerpb.Event {
+	return &setmakerpb.Event{
+		EventType: setmakerpb.Event_EVENT_SONG_RESTORED,
+		MessageBody: &setmakerpb.Event_SongRestored{
+			SongRestored: &setmakerpb.MessageBody_SongRestored{
+				Id:   song.Id,
+				Song: song,
+			},
+		},
+	}
+}
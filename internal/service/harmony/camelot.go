@@ -0,0 +1,111 @@
+package harmony
+
+import (
+	"fmt"
+
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+)
+
+// Position of a key on the Camelot wheel
+// Numbers run 1-12 round the circle of fifths, letter A is minor and B is major.
+// Relative major/minor keys share a number, adjacent numbers are a fifth apart
+type Camelot struct {
+	Number int
+	Minor  bool
+}
+
+// How a compatible key relates to the key it was matched against
+type Relation int
+
+const (
+	RelationSameKey Relation = iota
+	RelationRelative
+	RelationFifthUp
+	RelationFifthDown
+)
+
+
+func (c Camelot) String() string {
+	if c.Minor {
+		return fmt.Sprintf("%dA", c.Number)
+	}
+	return fmt.Sprintf("%dB", c.Number)
+}
+
+
+// Locate a key/tonality on the Camelot wheel
+// Only keys with a single tonic and a major or minor tonality have a position
+func ToCamelot(key setmakerpb.Key, tonality setmakerpb.Tonality) (Camelot, error) {
+	pc, ok := pitchClasses[key]
+	if !ok {
+		return Camelot{}, fmt.Errorf("key %s has no position on the Camelot wheel", key)
+	}
+
+	switch tonality {
+	case setmakerpb.Tonality_TONALITY_MAJOR:
+	case setmakerpb.Tonality_TONALITY_MINOR:
+		// minor keys sit with their relative major, three semitones up
+		pc = (pc + 3) % 12
+	default:
+		return Camelot{}, fmt.Errorf("tonality %s has no position on the Camelot wheel", tonality)
+	}
+
+	// C major is 8B and each fifth (7 semitones) moves one step clockwise
+	return Camelot{
+		Number: wrap((7*pc + 8) % 12),
+		Minor:  tonality == setmakerpb.Tonality_TONALITY_MINOR,
+	}, nil
+}
+
+
+// Key and tonality at a position on the Camelot wheel
+func (c Camelot) Key() (setmakerpb.Key, setmakerpb.Tonality) {
+	// 7 is its own inverse mod 12 so stepping back from 8B recovers the major tonic
+	pc := (7 * (c.Number - 8 + 12)) % 12
+	if !c.Minor {
+		return tonics[pc], setmakerpb.Tonality_TONALITY_MAJOR
+	}
+
+	return tonics[(pc+9)%12], setmakerpb.Tonality_TONALITY_MINOR
+}
+
+
+// Positions that mix harmonically with c: itself, its relative major/minor and the keys
+// a fifth either side with the same tonality
+func (c Camelot) Compatible() map[Camelot]Relation {
+	return map[Camelot]Relation{
+		c:                                   RelationSameKey,
+		{Number: c.Number, Minor: !c.Minor}: RelationRelative,
+		{Number: wrap(c.Number + 1), Minor: c.Minor}: RelationFifthUp,
+		{Number: wrap(c.Number - 1), Minor: c.Minor}: RelationFifthDown,
+	}
+}
+
+
+// Number of steps between two positions on the wheel
+// Moving one number round the wheel or switching between relative major/minor each cost one step
+func Distance(a, b Camelot) int {
+	d := a.Number - b.Number
+	if d < 0 {
+		d = -d
+	}
+	if d > 6 {
+		d = 12 - d
+	}
+
+	if a.Minor != b.Minor {
+		d++
+	}
+
+	return d
+}
+
+
+// wrap a wheel number into the range 1-12
+func wrap(n int) int {
+	n = ((n % 12) + 12) % 12
+	if n == 0 {
+		return 12
+	}
+	return n
+}
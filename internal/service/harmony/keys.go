@@ -0,0 +1,137 @@
+package harmony
+
+import (
+	"fmt"
+	"strings"
+
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+)
+
+// pitch class of each tonic, counting semitones up from C
+var pitchClasses = map[setmakerpb.Key]int{
+	setmakerpb.Key_KEY_C:       0,
+	setmakerpb.Key_KEY_C_SHARP: 1,
+	setmakerpb.Key_KEY_D:       2,
+	setmakerpb.Key_KEY_D_SHARP: 3,
+	setmakerpb.Key_KEY_E:       4,
+	setmakerpb.Key_KEY_F:       5,
+	setmakerpb.Key_KEY_F_SHARP: 6,
+	setmakerpb.Key_KEY_G:       7,
+	setmakerpb.Key_KEY_G_SHARP: 8,
+	setmakerpb.Key_KEY_A:       9,
+	setmakerpb.Key_KEY_B_FLAT:  10,
+	setmakerpb.Key_KEY_B:       11,
+}
+
+// tonic for each pitch class - the inverse of pitchClasses
+var tonics = [12]setmakerpb.Key{
+	setmakerpb.Key_KEY_C,
+	setmakerpb.Key_KEY_C_SHARP,
+	setmakerpb.Key_KEY_D,
+	setmakerpb.Key_KEY_D_SHARP,
+	setmakerpb.Key_KEY_E,
+	setmakerpb.Key_KEY_F,
+	setmakerpb.Key_KEY_F_SHARP,
+	setmakerpb.Key_KEY_G,
+	setmakerpb.Key_KEY_G_SHARP,
+	setmakerpb.Key_KEY_A,
+	setmakerpb.Key_KEY_B_FLAT,
+	setmakerpb.Key_KEY_B,
+}
+
+// pitch class of each natural note name
+var naturals = map[byte]int{
+	'C': 0,
+	'D': 2,
+	'E': 4,
+	'F': 5,
+	'G': 7,
+	'A': 9,
+	'B': 11,
+}
+
+
+// Parse a key spelling into a Key
+// Accepts enharmonic spellings ("F#", "Gb", "G flat", "F♯"), any case, and the enum names
+// themselves ("KEY_F_SHARP"). A trailing "m"/"min"/"minor" or "maj"/"major" is ignored - use
+// ParseTonality to read it
+func ParseKey(in string) (setmakerpb.Key, error) {
+	s := strings.TrimSpace(in)
+	if s == "" {
+		return setmakerpb.Key_KEY_UNKNOWN, fmt.Errorf("empty key")
+	}
+
+	// enum names
+	if k, ok := setmakerpb.Key_value[strings.ToUpper(s)]; ok {
+		return setmakerpb.Key(k), nil
+	}
+
+	s = strings.ToUpper(s)
+	s = strings.NewReplacer("♯", "#", "♭", "B", "-", " ", "_", " ").Replace(s)
+
+	pc, ok := naturals[s[0]]
+	if !ok {
+		return setmakerpb.Key_KEY_UNKNOWN, fmt.Errorf("unrecognised key %q", in)
+	}
+
+	// read accidentals
+	rest := strings.TrimSpace(s[1:])
+	for {
+		switch {
+		case strings.HasPrefix(rest, "#"):
+			pc++
+			rest = rest[1:]
+		case strings.HasPrefix(rest, "SHARP"):
+			pc++
+			rest = rest[len("SHARP"):]
+		case strings.HasPrefix(rest, "FLAT"):
+			pc--
+			rest = rest[len("FLAT"):]
+		case strings.HasPrefix(rest, "B"):
+			pc--
+			rest = rest[1:]
+		default:
+			if _, err := ParseTonality(rest); rest != "" && err != nil {
+				return setmakerpb.Key_KEY_UNKNOWN, fmt.Errorf("unrecognised key %q", in)
+			}
+			return tonics[(pc+12)%12], nil
+		}
+		rest = strings.TrimSpace(rest)
+	}
+}
+
+
+// Parse a tonality spelling ("minor", "min", "m", "major", "maj", "TONALITY_MINOR") into a Tonality
+func ParseTonality(in string) (setmakerpb.Tonality, error) {
+	s := strings.TrimSpace(in)
+	if t, ok := setmakerpb.Tonality_value[strings.ToUpper(s)]; ok {
+		return setmakerpb.Tonality(t), nil
+	}
+
+	// "m" is case sensitive in chord notation (Am vs AM) so check it before folding case
+	if s == "m" {
+		return setmakerpb.Tonality_TONALITY_MINOR, nil
+	}
+
+	switch strings.ToUpper(s) {
+	case "MINOR", "MIN":
+		return setmakerpb.Tonality_TONALITY_MINOR, nil
+	case "MAJOR", "MAJ", "M":
+		return setmakerpb.Tonality_TONALITY_MAJOR, nil
+	}
+
+	return setmakerpb.Tonality_TONALITY_UNKNOWN, fmt.Errorf("unrecognised tonality %q", in)
+}
+
+
+// Human readable name of a key, e.g. "F#"
+func KeyName(key setmakerpb.Key) string {
+	names := [12]string{"C", "C#", "D", "D#", "E", "F", "F#", "G", "G#", "A", "Bb", "B"}
+
+	pc, ok := pitchClasses[key]
+	if !ok {
+		return ""
+	}
+
+	return names[pc]
+}
@@ -0,0 +1,227 @@
+package harmony
+
+import "math"
+
+// largest number of positions ordered exactly. Beyond this a heuristic is used
+const exactOrderLimit = 12
+
+// No position pinned
+const Unpinned = -1
+
+
+// Order positions so the total Camelot distance between neighbours is as small as possible
+// Returns indexes into positions. opener and closer pin an index to the start or end of the
+// order - pass Unpinned to leave them free.
+// Small sets are solved exactly, larger ones use nearest neighbour followed by 2-opt
+func Order(positions []Camelot, opener, closer int) []int {
+	n := len(positions)
+	if n == 0 {
+		return nil
+	}
+	if n == 1 {
+		return []int{0}
+	}
+
+	dist := make([][]int, n)
+	for i := range positions {
+		dist[i] = make([]int, n)
+		for j := range positions {
+			dist[i][j] = Distance(positions[i], positions[j])
+		}
+	}
+
+	if n <= exactOrderLimit {
+		return orderExact(dist, opener, closer)
+	}
+
+	return orderHeuristic(dist, opener, closer)
+}
+
+
+// Total distance between neighbours when positions are played in order
+func PathDistance(positions []Camelot, order []int) int {
+	total := 0
+	for i := 1; i < len(order); i++ {
+		total += Distance(positions[order[i-1]], positions[order[i]])
+	}
+	return total
+}
+
+
+// Held-Karp dynamic programming over subsets of positions
+func orderExact(dist [][]int, opener, closer int) []int {
+	n := len(dist)
+	full := 1<<n - 1
+
+	cost := make([][]int, 1<<n)
+	parent := make([][]int, 1<<n)
+	for mask := range cost {
+		cost[mask] = make([]int, n)
+		parent[mask] = make([]int, n)
+		for i := range cost[mask] {
+			cost[mask][i] = math.MaxInt32
+			parent[mask][i] = -1
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		if opener != Unpinned && i != opener {
+			continue
+		}
+		if closer != Unpinned && i == closer {
+			continue
+		}
+		cost[1<<i][i] = 0
+	}
+
+	for mask := 1; mask <= full; mask++ {
+		for last := 0; last < n; last++ {
+			if cost[mask][last] == math.MaxInt32 {
+				continue
+			}
+
+			for next := 0; next < n; next++ {
+				if mask&(1<<next) != 0 {
+					continue
+				}
+				// the closer can only be visited once everything else has been
+				nextMask := mask | 1<<next
+				if next == closer && nextMask != full {
+					continue
+				}
+
+				c := cost[mask][last] + dist[last][next]
+				if c < cost[nextMask][next] {
+					cost[nextMask][next] = c
+					parent[nextMask][next] = last
+				}
+			}
+		}
+	}
+
+	// pick the cheapest end point
+	end := closer
+	if end == Unpinned {
+		for i := 0; i < n; i++ {
+			if end == Unpinned || cost[full][i] < cost[full][end] {
+				end = i
+			}
+		}
+	}
+
+	// walk the parents back to the start
+	order := make([]int, n)
+	mask := full
+	for i, last := n-1, end; i >= 0; i-- {
+		order[i] = last
+		prev := parent[mask][last]
+		mask &^= 1 << last
+		last = prev
+	}
+
+	return order
+}
+
+
+// nearest neighbour from each permitted start, improved with 2-opt
+func orderHeuristic(dist [][]int, opener, closer int) []int {
+	n := len(dist)
+
+	var best []int
+	bestCost := math.MaxInt32
+	for start := 0; start < n; start++ {
+		if opener != Unpinned && start != opener {
+			continue
+		}
+		if start == closer {
+			continue
+		}
+
+		order := nearestNeighbour(dist, start, closer)
+		twoOpt(dist, order, opener != Unpinned, closer != Unpinned)
+
+		if c := orderCost(dist, order); c < bestCost {
+			best, bestCost = order, c
+		}
+	}
+
+	return best
+}
+
+
+// greedily visit the closest unvisited position, saving the closer for last
+func nearestNeighbour(dist [][]int, start, closer int) []int {
+	n := len(dist)
+	visited := make([]bool, n)
+	order := make([]int, 0, n)
+
+	visited[start] = true
+	order = append(order, start)
+	if closer != Unpinned {
+		visited[closer] = true
+	}
+
+	for len(order) < n {
+		last := order[len(order)-1]
+		next := Unpinned
+		for i := 0; i < n; i++ {
+			if !visited[i] && (next == Unpinned || dist[last][i] < dist[last][next]) {
+				next = i
+			}
+		}
+
+		if next == Unpinned {
+			// only the closer remains
+			next = closer
+		}
+		visited[next] = true
+		order = append(order, next)
+	}
+
+	return order
+}
+
+
+// reverse segments of the order while doing so shortens it
+// pinned ends are never moved
+func twoOpt(dist [][]int, order []int, pinStart, pinEnd bool) {
+	n := len(order)
+	first, lastIdx := 0, n-1
+	if pinStart {
+		first = 1
+	}
+	if pinEnd {
+		lastIdx = n - 2
+	}
+
+	for improved := true; improved; {
+		improved = false
+		for i := first; i < lastIdx; i++ {
+			for j := i + 1; j <= lastIdx; j++ {
+				delta := 0
+				if i > 0 {
+					delta += dist[order[i-1]][order[j]] - dist[order[i-1]][order[i]]
+				}
+				if j < n-1 {
+					delta += dist[order[i]][order[j+1]] - dist[order[j]][order[j+1]]
+				}
+
+				if delta < 0 {
+					for l, r := i, j; l < r; l, r = l+1, r-1 {
+						order[l], order[r] = order[r], order[l]
+					}
+					improved = true
+				}
+			}
+		}
+	}
+}
+
+
+func orderCost(dist [][]int, order []int) int {
+	total := 0
+	for i := 1; i < len(order); i++ {
+		total += dist[order[i-1]][order[i]]
+	}
+	return total
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	logger "github.com/sirupsen/logrus"
+)
+
+// Publisher that only logs events
+// Used when running locally without an SNS topic
+type LogPublisher struct{}
+
+
+func NewLogPublisher() *LogPublisher {
+	return &LogPublisher{}
+}
+
+
+func (l *LogPublisher) Publish(ctx context.Context, event *setmakerpb.Event) (*string, error) {
+	messageId := uuid.New().String()
+
+	logger.WithFields(logger.Fields{
+		"MessageBody": event,
+		"messageId":   messageId,
+	}).Infof("LogPublisher: %s", setmakerpb.Event_EventType_name[int32(event.EventType)])
+
+	return &messageId, nil
+}
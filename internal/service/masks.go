@@ -0,0 +1,66 @@
+package service
+
+import (
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// field mask paths accepted by each update, named as in the proto request messages
+var (
+	ArtistUpdatePaths = []string{"name", "image"}
+	SongUpdatePaths   = []string{"title", "artistId", "key", "tonality"}
+)
+
+
+// Resolve the paths an update applies to
+// An empty mask means every updatable field. Unknown paths are rejected
+func maskPaths(mask *fieldmaskpb.FieldMask, allowed []string) ([]string, error) {
+	if len(mask.GetPaths()) == 0 {
+		return allowed, nil
+	}
+
+	known := make(map[string]bool, len(allowed))
+	for _, path := range allowed {
+		known[path] = true
+	}
+
+	for _, path := range mask.GetPaths() {
+		if !known[path] {
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown update mask path %q", path)
+		}
+	}
+
+	return mask.GetPaths(), nil
+}
+
+
+// copy the masked fields from src onto target
+func applyArtistMask(target *setmakerpb.Artist, src *setmakerpb.Artist, paths []string) {
+	for _, path := range paths {
+		switch path {
+		case "name":
+			target.Name = src.Name
+		case "image":
+			target.Image = src.Image
+		}
+	}
+}
+
+
+// copy the masked fields from src onto target
+func applySongMask(target *setmakerpb.Song, src *setmakerpb.Song, paths []string) {
+	for _, path := range paths {
+		switch path {
+		case "title":
+			target.Title = src.Title
+		case "artistId":
+			target.ArtistId = src.ArtistId
+		case "key":
+			target.Key = src.Key
+		case "tonality":
+			target.Tonality = src.Tonality
+		}
+	}
+}
@@ -0,0 +1,316 @@
+package search
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// length of the n-grams used to find words containing a search term
+const gramSize = 3
+
+// Kind of document held in the index
+type Kind int
+
+const (
+	KindArtist Kind = iota
+	KindSong
+)
+
+// A document to index
+type Document struct {
+	Tenant string
+	Kind   Kind
+	Id     string
+	Text   string
+}
+
+type Result struct {
+	Kind  Kind
+	Id    string
+	Score int
+}
+
+type docKey struct {
+	tenant string
+	kind   Kind
+	id     string
+}
+
+// when a tenant's catalog was last loaded, and whether a reload is under way
+type tenantLoad struct {
+	loadedAt   time.Time
+	refreshing bool
+}
+
+// In-memory inverted index supporting partial, case and accent insensitive matching
+// Words map to the documents containing them, and trigrams map to the words containing them
+// so a term can be matched anywhere inside a word. Documents belong to a tenant and searches
+// only ever match the searching tenant's documents
+type Index struct {
+	mu    sync.RWMutex
+	docs  map[docKey][]string
+	words map[string]map[docKey]bool
+	grams map[string]map[string]bool
+	// tenants whose catalogs have been loaded
+	loaded map[string]tenantLoad
+}
+
+
+func NewIndex() *Index {
+	return &Index{
+		docs:   make(map[docKey][]string),
+		words:  make(map[string]map[docKey]bool),
+		grams:  make(map[string]map[string]bool),
+		loaded: make(map[string]tenantLoad),
+	}
+}
+
+
+// Add a document, replacing any previous version of it
+func (i *Index) Put(doc Document) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.put(doc)
+}
+
+
+// add a document. callers must hold the write lock
+func (i *Index) put(doc Document) {
+	key := docKey{tenant: doc.Tenant, kind: doc.Kind, id: doc.Id}
+	i.remove(key)
+
+	words := Tokenise(doc.Text)
+	i.docs[key] = words
+
+	for _, word := range words {
+		if i.words[word] == nil {
+			i.words[word] = make(map[docKey]bool)
+
+			for _, gram := range grams(word) {
+				if i.grams[gram] == nil {
+					i.grams[gram] = make(map[string]bool)
+				}
+				i.grams[gram][word] = true
+			}
+		}
+		i.words[word][key] = true
+	}
+}
+
+
+// Remove a tenant's document
+func (i *Index) Remove(tenant string, kind Kind, id string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.remove(docKey{tenant: tenant, kind: kind, id: id})
+}
+
+
+// When a tenant's catalog was last loaded. zero if it never has been
+func (i *Index) LoadedAt(tenant string) time.Time {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return i.loaded[tenant].loadedAt
+}
+
+
+// Replace all of a tenant's documents with a freshly loaded catalog
+// documents put while the catalog was being read may be lost, until the next load picks them up
+func (i *Index) Load(tenant string, docs []Document, at time.Time) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for key := range i.docs {
+		if key.tenant == tenant {
+			i.remove(key)
+		}
+	}
+	for _, doc := range docs {
+		doc.Tenant = tenant
+		i.put(doc)
+	}
+
+	i.loaded[tenant] = tenantLoad{loadedAt: at}
+}
+
+
+// Claim the reload of a tenant's catalog that was loaded more than maxAge ago
+// only one caller gets the claim until Load or RefreshFailed is called
+func (i *Index) StartRefresh(tenant string, maxAge time.Duration, now time.Time) bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	load, ok := i.loaded[tenant]
+	if !ok || load.refreshing || now.Sub(load.loadedAt) < maxAge {
+		return false
+	}
+
+	load.refreshing = true
+	i.loaded[tenant] = load
+	return true
+}
+
+
+// Give up a claimed reload, keeping the documents there are so the next search tries again
+func (i *Index) RefreshFailed(tenant string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if load, ok := i.loaded[tenant]; ok {
+		load.refreshing = false
+		i.loaded[tenant] = load
+	}
+}
+
+
+// Number of documents in the index
+func (i *Index) Len() int {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return len(i.docs)
+}
+
+
+// Find a tenant's documents of a kind containing every term in the query
+// Results are ranked by how closely terms match: whole words beat prefixes, which beat
+// matches inside a word
+func (i *Index) Search(query string, tenant string, kind Kind, limit int) []Result {
+	terms := Tokenise(query)
+	if len(terms) == 0 {
+		return nil
+	}
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	var scores map[docKey]int
+	for _, term := range terms {
+		termScores := make(map[docKey]int)
+		for word, score := range i.matchWords(term) {
+			for key := range i.words[word] {
+				if key.tenant == tenant && key.kind == kind && score > termScores[key] {
+					termScores[key] = score
+				}
+			}
+		}
+
+		// every term must match
+		if scores == nil {
+			scores = termScores
+			continue
+		}
+		for key := range scores {
+			if termScores[key] == 0 {
+				delete(scores, key)
+				continue
+			}
+			scores[key] += termScores[key]
+		}
+	}
+
+	results := make([]Result, 0, len(scores))
+	for key, score := range scores {
+		results = append(results, Result{Kind: key.kind, Id: key.id, Score: score})
+	}
+
+	sort.Slice(results, func(a, b int) bool {
+		if results[a].Score != results[b].Score {
+			return results[a].Score > results[b].Score
+		}
+		return results[a].Id < results[b].Id
+	})
+
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+
+	return results
+}
+
+
+// words containing a term, scored by how closely they match it
+func (i *Index) matchWords(term string) map[string]int {
+	matches := make(map[string]int)
+
+	var candidates map[string]bool
+	if len([]rune(term)) < gramSize {
+		// too short for the gram index, so check every word
+		candidates = make(map[string]bool, len(i.words))
+		for word := range i.words {
+			candidates[word] = true
+		}
+	} else {
+		// a word containing the term contains all of its grams
+		for _, gram := range grams(term) {
+			words := i.grams[gram]
+			if candidates == nil {
+				candidates = make(map[string]bool, len(words))
+				for word := range words {
+					candidates[word] = true
+				}
+				continue
+			}
+			for word := range candidates {
+				if !words[word] {
+					delete(candidates, word)
+				}
+			}
+		}
+	}
+
+	for word := range candidates {
+		switch {
+		case word == term:
+			matches[word] = 3
+		case strings.HasPrefix(word, term):
+			matches[word] = 2
+		case strings.Contains(word, term):
+			matches[word] = 1
+		}
+	}
+
+	return matches
+}
+
+
+// remove a document. callers must hold the write lock
+func (i *Index) remove(key docKey) {
+	for _, word := range i.docs[key] {
+		delete(i.words[word], key)
+		if len(i.words[word]) > 0 {
+			continue
+		}
+
+		// last document using the word, so drop it from the gram index too
+		delete(i.words, word)
+		for _, gram := range grams(word) {
+			delete(i.grams[gram], word)
+			if len(i.grams[gram]) == 0 {
+				delete(i.grams, gram)
+			}
+		}
+	}
+
+	delete(i.docs, key)
+}
+
+
+// overlapping n-grams of a word. words shorter than gramSize have none
+func grams(word string) []string {
+	r := []rune(word)
+	if len(r) < gramSize {
+		return nil
+	}
+
+	out := make([]string, 0, len(r)-gramSize+1)
+	for i := 0; i+gramSize <= len(r); i++ {
+		out = append(out, string(r[i:i+gramSize]))
+	}
+	return out
+}
@@ -2,38 +2,132 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	repository "github.com/pete-robinson/set-maker-grpc/internal/repository/ddb"
+	"github.com/pete-robinson/set-maker-grpc/internal/service/search"
 	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultPageSize int32 = 25
+	MaxPageSize     int32 = 100
+	// max number of ids accepted by a batch get
+	MaxBatchGetIds = 100
 )
 
 type Repository interface {
 	ListArtists(context.Context, int32, string) (*repository.ArtistList, error)
 	GetArtist(context.Context, uuid.UUID) (*setmakerpb.Artist, error)
-	PutArtist(context.Context, *setmakerpb.Artist) error
-	DeleteArtist(context.Context, uuid.UUID) error
+	GetArtistByName(context.Context, string) (*setmakerpb.Artist, error)
+	BatchGetArtists(context.Context, []uuid.UUID) (map[string]*setmakerpb.Artist, error)
+	PutArtist(context.Context, *setmakerpb.Artist, ...*setmakerpb.Event) error
+	GetDeletedArtist(context.Context, uuid.UUID) (*setmakerpb.Artist, error)
+	ListDeletedArtists(context.Context, int32, string) (*repository.ArtistList, error)
 
-	ListSongs(context.Context, int32, string) (*repository.SongList, error)
+	ListSongs(context.Context, int32, string, repository.SongQuery) (*repository.SongList, error)
 	ListSongsByArtist(context.Context, int32, string, string) (*repository.SongList, error)
+	ListSongsByKey(context.Context, int32, string, setmakerpb.Key, setmakerpb.Tonality) (*repository.SongList, error)
 	GetSong(context.Context, uuid.UUID) (*setmakerpb.Song, error)
-	PutSong(context.Context, *setmakerpb.Song) error
-	DeleteSong(context.Context, uuid.UUID) error
-}
+	BatchGetSongs(context.Context, []uuid.UUID) (map[string]*setmakerpb.Song, error)
+	PutSong(context.Context, *setmakerpb.Song, ...*setmakerpb.Event) error
+	PutSongs(context.Context, []*setmakerpb.Song, []*setmakerpb.Event) error
+	UpdateSongs(context.Context, []*setmakerpb.Song, []*setmakerpb.Event) error
+	GetDeletedSong(context.Context, uuid.UUID) (*setmakerpb.Song, error)
 
-type Notifier interface {
-	RaiseArtistCreatedEvent(context.Context, *setmakerpb.Artist) error
+	ListSetlists(context.Context, int32, string) (*repository.SetlistList, error)
+	GetSetlist(context.Context, uuid.UUID) (*setmakerpb.Setlist, error)
+	PutSetlist(context.Context, *setmakerpb.Setlist) error
+	DeleteSetlist(context.Context, uuid.UUID) error
 }
 
+// Domain events are passed to the repository with the change that raised them and
+// written to the outbox in the same transaction. The outbox relay publishes them.
+// The search index is held in memory, kept up to date by the service's own writes and rebuilt
+// from the repository every SearchIndexRefresh to pick up writes made through other servers.
+// Deletes are soft: deleted artists and songs are written back with Metadata.DeletedAt set and
+// stay restorable until the repository purges them
 type Service struct {
 	repository Repository
-	snsClient  Notifier
+	search     *search.Index
+	clock      Clock
 }
 
+// Source of the time records are stamped with
+type Clock func() time.Time
+
 
-func NewService(repo Repository, sns Notifier) *Service {
+func NewService(repo Repository) *Service {
 	return &Service{
 		repository: repo,
-		snsClient:  sns,
+		search:     search.NewIndex(),
+		clock:      time.Now,
+	}
+}
+
+
+// Replace the clock, so tests can control the timestamps records are written with
+func (s *Service) SetClock(clock Clock) {
+	s.clock = clock
+}
+
+
+// default and cap the page size requested by a client
+func pageSize(limit int32) int32 {
+	if limit <= 0 {
+		return DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		return MaxPageSize
+	}
+
+	return limit
+}
+
+
+// parse the ids of a batch get, dropping repeats so each id keeps its first position
+func parseBatchIds(ids []string) ([]uuid.UUID, error) {
+	if len(ids) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "At least one Id is required")
+	}
+	if len(ids) > MaxBatchGetIds {
+		return nil, status.Errorf(codes.InvalidArgument, "At most %d Ids can be fetched at once", MaxBatchGetIds)
+	}
+
+	seen := make(map[uuid.UUID]bool, len(ids))
+	res := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			logger.WithField("uuid", id).Errorf("Could not parse UUID: %s", err)
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid Id %q", id)
+		}
+
+		if !seen[parsed] {
+			seen[parsed] = true
+			res = append(res, parsed)
+		}
 	}
+
+	return res, nil
+}
+
+
+// reject an update made against a stale copy of a record
+// an expected version of 0 means the client didn't ask for the check
+func checkExpectedVersion(meta *setmakerpb.Metadata, expectedVersion int64) error {
+	if expectedVersion == 0 || meta.GetVersion() == expectedVersion {
+		return nil
+	}
+
+	logger.WithFields(logger.Fields{
+		"expected": expectedVersion,
+		"stored": meta.GetVersion(),
+	}).Warn("Version mismatch on update")
+
+	return status.Errorf(codes.Aborted, "Record is at version %d, not %d. Fetch it and try again", meta.GetVersion(), expectedVersion)
 }
@@ -2,19 +2,30 @@ package service
 
 import (
 	"context"
+	"strings"
 
 	"github.com/google/uuid"
 	repository "github.com/pete-robinson/set-maker-grpc/internal/repository/ddb"
+	"github.com/pete-robinson/set-maker-grpc/internal/service/search"
+	"github.com/pete-robinson/set-maker-grpc/internal/service/validation"
 	"github.com/pete-robinson/set-maker-grpc/internal/utils"
 	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
 	logger "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 
+type BatchArtists struct {
+	Artists    []*setmakerpb.Artist
+	MissingIds []string
+}
+
+
 func (s *Service) ListArtists(ctx context.Context, limit int32, cursor string) (*repository.ArtistList, error) {
-	res, err := s.repository.ListArtists(ctx, limit, cursor)
+	res, err := s.repository.ListArtists(ctx, pageSize(limit), cursor)
 	if err != nil {
 		return nil, err
 	}
@@ -39,26 +50,83 @@ func (s *Service) GetArtist(ctx context.Context, id uuid.UUID) (*setmakerpb.Arti
 }
 
 
+// Get a set of artists in the order requested
+// repeated ids are returned once, at their first position. ids with no artist are listed as missing
+func (s *Service) BatchGetArtists(ctx context.Context, ids []string) (*BatchArtists, error) {
+	parsed, err := parseBatchIds(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := s.repository.BatchGetArtists(ctx, parsed)
+	if err != nil {
+		logger.WithField("ids", ids).Errorf("Could not fetch artists: %s", err)
+		return nil, err
+	}
+
+	res := &BatchArtists{}
+	for _, id := range parsed {
+		if artist, ok := found[id.String()]; ok {
+			res.Artists = append(res.Artists, artist)
+		} else {
+			res.MissingIds = append(res.MissingIds, id.String())
+		}
+	}
+
+	return res, nil
+}
+
+
+// Get artist by name, ignoring case and surrounding whitespace
+func (s *Service) GetArtistByName(ctx context.Context, name string) (*setmakerpb.Artist, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "Artist name is required")
+	}
+
+	artist, err := s.repository.GetArtistByName(ctx, name)
+	if err != nil {
+		logger.WithField("name", name).Errorf("Could not fetch artist by name: %s", err)
+		return nil, err
+	}
+
+	return artist, nil
+}
+
+
 func (s *Service) CreateArtist(ctx context.Context, artist *setmakerpb.Artist) (*setmakerpb.Artist, error) {
+	if err := validation.ValidateArtist(artist, ArtistUpdatePaths); err != nil {
+		return nil, err
+	}
+
 	// init UUID and meta
 	artist.Id = uuid.New().String()
 	artist.Metadata = &setmakerpb.Metadata{}
-	utils.SetMetaData(artist.Metadata)
+	utils.SetMetaData(ctx, artist.Metadata, s.clock())
 
-	if err := s.repository.PutArtist(ctx, artist); err != nil {
+	if err := s.repository.PutArtist(ctx, artist, newArtistCreatedEvent(artist)); err != nil {
 		logger.WithField("data", artist).Errorf("Could not create artist: %s", err)
 		return nil, err
 	}
-
-	// do nothing with this error for now
-	// error is logged if one occurs and we don't want to disrupt the persistence response
-	_ = s.snsClient.RaiseArtistCreatedEvent(ctx, artist)
+	s.indexArtist(ctx, artist)
 
 	return artist, nil
 }
 
 
-func (s *Service) UpdateArtist(ctx context.Context, artist *setmakerpb.Artist) (*setmakerpb.Artist, error) {
+// Update an artist
+// Only the fields named in mask are changed, or every field if the mask is empty.
+// If expectedVersion is set the update is rejected unless it matches the stored version.
+// Either way the write fails with Aborted if the artist changes between being read and written
+func (s *Service) UpdateArtist(ctx context.Context, artist *setmakerpb.Artist, mask *fieldmaskpb.FieldMask, expectedVersion int64) (*setmakerpb.Artist, error) {
+	paths, err := maskPaths(mask, ArtistUpdatePaths)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = validation.ValidateArtist(artist, paths); err != nil {
+		return nil, err
+	}
+
 	// fetch the artist to update
 	targetId, err := uuid.Parse(artist.Id)
 	if err != nil {
@@ -70,24 +138,176 @@ func (s *Service) UpdateArtist(ctx context.Context, artist *setmakerpb.Artist) (
 		return nil, status.Error(codes.NotFound, "Artist to update does not exist")
 	}
 
-	// reset the data
-	target.Name = artist.Name
-	target.Image = artist.Image
-	utils.SetMetaData(target.Metadata)
+	if err = checkExpectedVersion(target.Metadata, expectedVersion); err != nil {
+		return nil, err
+	}
+	before := proto.Clone(target).(*setmakerpb.Artist)
+
+	// reset the masked data
+	applyArtistMask(target, artist, paths)
+	utils.SetMetaData(ctx, target.Metadata, s.clock())
 
 	// update artist
-	if err = s.repository.PutArtist(ctx, target); err != nil {
+	if err = s.repository.PutArtist(ctx, target, newArtistUpdatedEvent(before, target)); err != nil {
 		return nil, err
 	}
+	s.indexArtist(ctx, target)
 
 	return target, nil
 }
 
 
-func (s *Service) DeleteArtist(ctx context.Context, id uuid.UUID) error {
-	if err := s.repository.DeleteArtist(ctx, id); err != nil {
-		return err
+// number of songs fetched and deleted per batch when cascading an artist delete or restore
+const CascadeBatchSize int32 = 25
+
+
+// Delete an artist, applying the deletion policy to any songs that reference it
+// The artist and any cascaded songs are moved to the trash and can be restored with RestoreArtist.
+// With the cascade policy the artist is trashed before its songs, so a cascade that fails partway
+// leaves a trashed artist whose remaining songs are trashed by deleting it again with the cascade policy.
+// Returns the number of songs deleted alongside the artist
+func (s *Service) DeleteArtist(ctx context.Context, id uuid.UUID, policy setmakerpb.ArtistDeletionPolicy) (int32, error) {
+	// snapshot the artist for the deleted event
+	artist, err := s.GetArtist(ctx, id)
+	if status.Code(err) == codes.NotFound && policy == setmakerpb.ArtistDeletionPolicy_ARTIST_DELETION_POLICY_CASCADE {
+		return s.resumeArtistCascade(ctx, id, err)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	switch policy {
+	case setmakerpb.ArtistDeletionPolicy_ARTIST_DELETION_POLICY_RESTRICT:
+		hasSongs, err := s.artistHasSongs(ctx, id)
+		if err != nil {
+			return 0, err
+		}
+
+		if hasSongs {
+			return 0, status.Error(codes.FailedPrecondition, "Artist still has songs. Delete them first or use the cascade policy")
+		}
+
+	case setmakerpb.ArtistDeletionPolicy_ARTIST_DELETION_POLICY_CASCADE:
+		// the songs are trashed once the artist is
+
+	default:
+		return 0, status.Error(codes.InvalidArgument, "Unknown deletion policy")
+	}
+
+	target := proto.Clone(artist).(*setmakerpb.Artist)
+	utils.SetDeleted(ctx, target.Metadata, s.clock())
+
+	if err := s.repository.PutArtist(ctx, target, newArtistDeletedEvent(artist)); err != nil {
+		return 0, err
+	}
+	s.unindex(ctx, search.KindArtist, artist.Id)
+
+	if policy != setmakerpb.ArtistDeletionPolicy_ARTIST_DELETION_POLICY_CASCADE {
+		return 0, nil
+	}
+
+	return s.cascadeArtistDelete(ctx, id, target.Metadata.DeletedAt)
+}
+
+
+// whether any live song references the artist
+// pages are read a cascade batch at a time so trashed songs are skipped in bulk. a page can still
+// come back empty with a cursor when the songs read for it were all trashed, so keep paging until
+// a song turns up or the artist's songs run out
+func (s *Service) artistHasSongs(ctx context.Context, id uuid.UUID) (bool, error) {
+	cursor := ""
+	for {
+		songs, err := s.repository.ListSongsByArtist(ctx, CascadeBatchSize, cursor, id.String())
+		if err != nil {
+			logger.WithField("id", id).Errorf("Could not check songs for artist: %s", err)
+			return false, err
+		}
+
+		if songs.Count > 0 {
+			return true, nil
+		}
+		if songs.Cursor == "" {
+			return false, nil
+		}
+		cursor = songs.Cursor
+	}
+}
+
+
+// finish the cascade of an artist already in the trash, whose songs may not all have been trashed
+// notFound is returned as is when the artist isn't in the trash either
+func (s *Service) resumeArtistCascade(ctx context.Context, id uuid.UUID, notFound error) (int32, error) {
+	artist, err := s.repository.GetDeletedArtist(ctx, id)
+	if status.Code(err) == codes.NotFound {
+		return 0, notFound
 	}
+	if err != nil {
+		return 0, err
+	}
+
+	logger.WithField("id", id).Info("Resuming cascade delete for artist in the trash")
+	return s.cascadeArtistDelete(ctx, id, artist.Metadata.DeletedAt)
+}
+
+
+// trash the songs of an artist that has been trashed at deletedAt
+// a failure partway is reported as such, as the artist and the songs before it are already trashed
+func (s *Service) cascadeArtistDelete(ctx context.Context, id uuid.UUID, deletedAt string) (int32, error) {
+	deleted, err := s.deleteSongsByArtist(ctx, id, deletedAt)
+	if err != nil {
+		return deleted, status.Errorf(status.Code(err), "Artist deleted but only %d of its songs were. Delete the artist again with the cascade policy to finish: %s", deleted, status.Convert(err).Message())
+	}
+
+	return deleted, nil
+}
+
+
+// walk the artist's songs a page at a time, deleting each page as a batch
+// the songs share the artist's DeletedAt, which is how RestoreArtist tells them apart from songs
+// that were deleted on their own
+func (s *Service) deleteSongsByArtist(ctx context.Context, id uuid.UUID, deletedAt string) (int32, error) {
+	var deleted int32
+	cursor := ""
+
+	for {
+		songs, err := s.repository.ListSongsByArtist(ctx, CascadeBatchSize, cursor, id.String())
+		if err != nil {
+			logger.WithField("id", id).Errorf("Could not list songs for artist: %s", err)
+			return deleted, err
+		}
+
+		targets := make([]*setmakerpb.Song, 0, len(songs.Items))
+		events := make([]*setmakerpb.Event, 0, len(songs.Items))
+		for _, song := range songs.Items {
+			target := proto.Clone(song).(*setmakerpb.Song)
+			target.Metadata.DeletedAt = deletedAt
+			utils.SetMetaData(ctx, target.Metadata, s.clock())
+
+			targets = append(targets, target)
+			events = append(events, newSongDeletedEvent(song))
+		}
+
+		if len(targets) > 0 {
+			if err = s.repository.UpdateSongs(ctx, targets, events); err != nil {
+				return deleted, err
+			}
+			deleted += int32(len(targets))
+
+			for _, song := range songs.Items {
+				s.unindex(ctx, search.KindSong, song.Id)
+			}
+		}
+
+		if songs.Cursor == "" {
+			break
+		}
+		cursor = songs.Cursor
+	}
+
+	logger.WithFields(logger.Fields{
+		"artist": id,
+		"deleted": deleted,
+	}).Info("Cascade deleted songs for artist")
 
-	return nil
+	return deleted, nil
 }
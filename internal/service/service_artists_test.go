@@ -0,0 +1,385 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/pete-robinson/set-maker-grpc/internal/repository/memory"
+	"github.com/pete-robinson/set-maker-grpc/internal/service"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCreatedRecordsCanBeReadBack(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := tenantContext("a")
+
+	artist := createArtist(t, ctx, svc, "Artist")
+	song := createSong(t, ctx, svc, artist.Id, "Title", setmakerpb.Key_KEY_C, setmakerpb.Tonality_TONALITY_MAJOR)
+
+	storedArtist, err := svc.GetArtist(ctx, mustParse(t, artist.Id))
+	if err != nil {
+		t.Fatalf("GetArtist returned error: %s", err)
+	}
+	if !proto.Equal(storedArtist, artist) {
+		t.Errorf("GetArtist = %v, want %v", storedArtist, artist)
+	}
+
+	storedSong, err := svc.GetSong(ctx, mustParse(t, song.Id))
+	if err != nil {
+		t.Fatalf("GetSong returned error: %s", err)
+	}
+	if !proto.Equal(storedSong, song) {
+		t.Errorf("GetSong = %v, want %v", storedSong, song)
+	}
+
+	_, err = svc.GetArtist(ctx, uuid.New())
+	assertCode(t, err, codes.NotFound)
+
+	_, err = svc.CreateSong(ctx, &setmakerpb.Song{Title: "Orphan", ArtistId: uuid.New().String(), Key: setmakerpb.Key_KEY_C, Tonality: setmakerpb.Tonality_TONALITY_MAJOR})
+	assertCode(t, err, codes.NotFound)
+}
+
+
+const (
+	restrict = setmakerpb.ArtistDeletionPolicy_ARTIST_DELETION_POLICY_RESTRICT
+	cascade  = setmakerpb.ArtistDeletionPolicy_ARTIST_DELETION_POLICY_CASCADE
+)
+
+func TestDeleteArtistRestrictRejectsArtistWithSongs(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := tenantContext("a")
+
+	artist := createArtist(t, ctx, svc, "Artist")
+	song := createSong(t, ctx, svc, artist.Id, "Title", setmakerpb.Key_KEY_C, setmakerpb.Tonality_TONALITY_MAJOR)
+
+	_, err := svc.DeleteArtist(ctx, mustParse(t, artist.Id), restrict)
+	assertCode(t, err, codes.FailedPrecondition)
+
+	if _, err = svc.GetArtist(ctx, mustParse(t, artist.Id)); err != nil {
+		t.Fatalf("artist should still exist, GetArtist returned error: %s", err)
+	}
+
+	// trashed songs don't hold the artist back
+	if err = svc.DeleteSong(ctx, mustParse(t, song.Id)); err != nil {
+		t.Fatalf("DeleteSong returned error: %s", err)
+	}
+	deleted, err := svc.DeleteArtist(ctx, mustParse(t, artist.Id), restrict)
+	if err != nil {
+		t.Fatalf("DeleteArtist returned error: %s", err)
+	}
+	if deleted != 0 {
+		t.Errorf("deleted %d songs, want 0", deleted)
+	}
+
+	_, err = svc.GetArtist(ctx, mustParse(t, artist.Id))
+	assertCode(t, err, codes.NotFound)
+}
+
+
+func TestDeleteArtistCascadeTrashesSongs(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := tenantContext("a")
+
+	artist := createArtist(t, ctx, svc, "Artist")
+	other := createArtist(t, ctx, svc, "Other")
+	for i := 0; i < 30; i++ {
+		createSong(t, ctx, svc, artist.Id, fmt.Sprintf("Song %d", i), setmakerpb.Key_KEY_C, setmakerpb.Tonality_TONALITY_MAJOR)
+	}
+	kept := createSong(t, ctx, svc, other.Id, "Kept", setmakerpb.Key_KEY_C, setmakerpb.Tonality_TONALITY_MAJOR)
+
+	deleted, err := svc.DeleteArtist(ctx, mustParse(t, artist.Id), cascade)
+	if err != nil {
+		t.Fatalf("DeleteArtist returned error: %s", err)
+	}
+	if deleted != 30 {
+		t.Errorf("deleted %d songs, want 30", deleted)
+	}
+
+	songs, err := svc.ListSongs(ctx, &setmakerpb.ListSongsRequest{Limit: 100, ArtistId: artist.Id})
+	if err != nil {
+		t.Fatalf("ListSongs returned error: %s", err)
+	}
+	if songs.Count != 0 {
+		t.Errorf("artist still has %d live songs", songs.Count)
+	}
+
+	if _, err = svc.GetSong(ctx, mustParse(t, kept.Id)); err != nil {
+		t.Errorf("another artist's song was deleted: %s", err)
+	}
+}
+
+
+func TestDeleteArtistRejectsUnknownPolicy(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := tenantContext("a")
+
+	artist := createArtist(t, ctx, svc, "Artist")
+
+	_, err := svc.DeleteArtist(ctx, mustParse(t, artist.Id), setmakerpb.ArtistDeletionPolicy(99))
+	assertCode(t, err, codes.InvalidArgument)
+}
+
+
+// a repository whose song batch updates start failing after a number of calls
+type failingRepository struct {
+	*memory.MemoryRepository
+	updatesLeft int
+}
+
+
+func (r *failingRepository) UpdateSongs(ctx context.Context, songs []*setmakerpb.Song, events []*setmakerpb.Event) error {
+	if r.updatesLeft == 0 {
+		return status.Error(codes.Unavailable, "Repository unavailable")
+	}
+	r.updatesLeft--
+
+	return r.MemoryRepository.UpdateSongs(ctx, songs, events)
+}
+
+
+func TestDeleteArtistCascadeResumesAfterPartialFailure(t *testing.T) {
+	_, repo := newTestService(t)
+	failing := &failingRepository{MemoryRepository: repo, updatesLeft: 1}
+	svc := service.NewService(failing)
+	ctx := tenantContext("a")
+
+	artist := createArtist(t, ctx, svc, "Artist")
+	count := int(service.CascadeBatchSize) + 5
+	for i := 0; i < count; i++ {
+		createSong(t, ctx, svc, artist.Id, fmt.Sprintf("Song %d", i), setmakerpb.Key_KEY_C, setmakerpb.Tonality_TONALITY_MAJOR)
+	}
+
+	// the first batch is trashed, then the cascade fails
+	deleted, err := svc.DeleteArtist(ctx, mustParse(t, artist.Id), cascade)
+	assertCode(t, err, codes.Unavailable)
+	if deleted != service.CascadeBatchSize {
+		t.Errorf("deleted %d songs before failing, want %d", deleted, service.CascadeBatchSize)
+	}
+
+	_, err = svc.GetArtist(ctx, mustParse(t, artist.Id))
+	assertCode(t, err, codes.NotFound)
+
+	// only a cascade picks up an artist that is already in the trash
+	_, err = svc.DeleteArtist(ctx, mustParse(t, artist.Id), restrict)
+	assertCode(t, err, codes.NotFound)
+
+	// deleting again finishes the cascade
+	failing.updatesLeft = -1
+	deleted, err = svc.DeleteArtist(ctx, mustParse(t, artist.Id), cascade)
+	if err != nil {
+		t.Fatalf("resumed DeleteArtist returned error: %s", err)
+	}
+	if deleted != 5 {
+		t.Errorf("resumed cascade deleted %d songs, want 5", deleted)
+	}
+
+	// and every song comes back with the artist
+	_, restored, err := svc.RestoreArtist(ctx, mustParse(t, artist.Id))
+	if err != nil {
+		t.Fatalf("RestoreArtist returned error: %s", err)
+	}
+	if restored != int32(count) {
+		t.Errorf("restored %d songs, want %d", restored, count)
+	}
+}
+
+
+func TestUpdateArtistChecksExpectedVersion(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := tenantContext("a")
+
+	artist := createArtist(t, ctx, svc, "Artist")
+
+	_, err := svc.UpdateArtist(ctx, &setmakerpb.Artist{Id: artist.Id, Name: "Renamed"}, mask("name"), artist.Metadata.Version+1)
+	assertCode(t, err, codes.Aborted)
+
+	updated, err := svc.UpdateArtist(ctx, &setmakerpb.Artist{Id: artist.Id, Name: "Renamed"}, mask("name"), artist.Metadata.Version)
+	if err != nil {
+		t.Fatalf("UpdateArtist returned error: %s", err)
+	}
+	if updated.Name != "Renamed" || updated.Metadata.Version != artist.Metadata.Version+1 {
+		t.Errorf("got %q at version %d, want %q at version %d", updated.Name, updated.Metadata.Version, "Renamed", artist.Metadata.Version+1)
+	}
+	if updated.Metadata.CreatedAt != artist.Metadata.CreatedAt {
+		t.Errorf("CreatedAt changed from %q to %q", artist.Metadata.CreatedAt, updated.Metadata.CreatedAt)
+	}
+}
+
+
+func TestListArtistsPagesThroughEveryArtist(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := tenantContext("a")
+
+	want := make(map[string]bool)
+	for i := 0; i < 7; i++ {
+		want[createArtist(t, ctx, svc, fmt.Sprintf("Artist %d", i)).Id] = true
+	}
+
+	seen := make(map[string]bool)
+	cursor := ""
+	pages := 0
+	for {
+		res, err := svc.ListArtists(ctx, 3, cursor)
+		if err != nil {
+			t.Fatalf("ListArtists returned error: %s", err)
+		}
+		pages++
+
+		if res.Count != int32(len(res.Items)) {
+			t.Errorf("Count = %d, but %d items were returned", res.Count, len(res.Items))
+		}
+		if res.TotalEstimate != 7 {
+			t.Errorf("TotalEstimate = %d, want 7", res.TotalEstimate)
+		}
+		for _, artist := range res.Items {
+			if seen[artist.Id] {
+				t.Errorf("artist %s returned on more than one page", artist.Id)
+			}
+			seen[artist.Id] = true
+		}
+
+		if res.Cursor == "" {
+			break
+		}
+		cursor = res.Cursor
+	}
+
+	if pages != 3 {
+		t.Errorf("took %d pages, want 3", pages)
+	}
+	if len(seen) != len(want) {
+		t.Errorf("saw %d artists, want %d", len(seen), len(want))
+	}
+}
+
+
+func TestRestoreArtistRestoresOnlyCascadedSongs(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := tenantContext("a")
+
+	artist := createArtist(t, ctx, svc, "Artist")
+	cascaded := createSong(t, ctx, svc, artist.Id, "Cascaded", setmakerpb.Key_KEY_C, setmakerpb.Tonality_TONALITY_MAJOR)
+	alone := createSong(t, ctx, svc, artist.Id, "Alone", setmakerpb.Key_KEY_C, setmakerpb.Tonality_TONALITY_MAJOR)
+
+	if err := svc.DeleteSong(ctx, mustParse(t, alone.Id)); err != nil {
+		t.Fatalf("DeleteSong returned error: %s", err)
+	}
+	if _, err := svc.DeleteArtist(ctx, mustParse(t, artist.Id), cascade); err != nil {
+		t.Fatalf("DeleteArtist returned error: %s", err)
+	}
+
+	trash, err := svc.ListDeletedArtists(ctx, 10, "")
+	if err != nil {
+		t.Fatalf("ListDeletedArtists returned error: %s", err)
+	}
+	if trash.Count != 1 || trash.Items[0].Id != artist.Id {
+		t.Errorf("trash holds %v, want only %s", trash.Items, artist.Id)
+	}
+
+	// a song can't come back before its artist
+	_, err = svc.RestoreSong(ctx, mustParse(t, cascaded.Id))
+	assertCode(t, err, codes.FailedPrecondition)
+
+	restoredArtist, restored, err := svc.RestoreArtist(ctx, mustParse(t, artist.Id))
+	if err != nil {
+		t.Fatalf("RestoreArtist returned error: %s", err)
+	}
+	if restored != 1 {
+		t.Errorf("restored %d songs, want 1", restored)
+	}
+	if restoredArtist.Metadata.DeletedAt != "" {
+		t.Errorf("restored artist still has DeletedAt %q", restoredArtist.Metadata.DeletedAt)
+	}
+
+	if _, err = svc.GetSong(ctx, mustParse(t, cascaded.Id)); err != nil {
+		t.Errorf("cascaded song was not restored: %s", err)
+	}
+	_, err = svc.GetSong(ctx, mustParse(t, alone.Id))
+	assertCode(t, err, codes.NotFound)
+
+	// the song deleted on its own can be restored separately
+	song, err := svc.RestoreSong(ctx, mustParse(t, alone.Id))
+	if err != nil {
+		t.Fatalf("RestoreSong returned error: %s", err)
+	}
+	if song.Metadata.Version != alone.Metadata.Version+2 {
+		t.Errorf("version = %d, want %d after a delete and a restore", song.Metadata.Version, alone.Metadata.Version+2)
+	}
+}
+
+
+func TestDeletedArtistReleasesItsName(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := tenantContext("a")
+
+	artist := createArtist(t, ctx, svc, "Artist")
+	song := createSong(t, ctx, svc, artist.Id, "Song", setmakerpb.Key_KEY_C, setmakerpb.Tonality_TONALITY_MAJOR)
+
+	_, err := svc.CreateArtist(ctx, &setmakerpb.Artist{Name: "artist"})
+	assertCode(t, err, codes.AlreadyExists)
+
+	if _, err = svc.DeleteArtist(ctx, mustParse(t, artist.Id), cascade); err != nil {
+		t.Fatalf("DeleteArtist returned error: %s", err)
+	}
+	createArtist(t, ctx, svc, "Artist")
+
+	// the name has been taken while the original was in the trash
+	_, _, err = svc.RestoreArtist(ctx, mustParse(t, artist.Id))
+	assertCode(t, err, codes.AlreadyExists)
+
+	// and its songs stay in the trash with it
+	_, err = svc.GetSong(ctx, mustParse(t, song.Id))
+	assertCode(t, err, codes.NotFound)
+}
+
+
+func TestRestoreArtistResumesAfterPartialFailure(t *testing.T) {
+	_, repo := newTestService(t)
+	failing := &failingRepository{MemoryRepository: repo, updatesLeft: -1}
+	svc := service.NewService(failing)
+	ctx := tenantContext("a")
+
+	artist := createArtist(t, ctx, svc, "Artist")
+	count := int(service.CascadeBatchSize) + 5
+	for i := 0; i < count; i++ {
+		createSong(t, ctx, svc, artist.Id, fmt.Sprintf("Song %d", i), setmakerpb.Key_KEY_C, setmakerpb.Tonality_TONALITY_MAJOR)
+	}
+
+	if _, err := svc.DeleteArtist(ctx, mustParse(t, artist.Id), cascade); err != nil {
+		t.Fatalf("DeleteArtist returned error: %s", err)
+	}
+
+	// the first batch is restored, then the restore fails before the artist is
+	failing.updatesLeft = 1
+	_, restored, err := svc.RestoreArtist(ctx, mustParse(t, artist.Id))
+	assertCode(t, err, codes.Unavailable)
+	if restored != service.CascadeBatchSize {
+		t.Errorf("restored %d songs before failing, want %d", restored, service.CascadeBatchSize)
+	}
+
+	_, err = svc.GetArtist(ctx, mustParse(t, artist.Id))
+	assertCode(t, err, codes.NotFound)
+
+	// restoring again finishes the job
+	failing.updatesLeft = -1
+	_, restored, err = svc.RestoreArtist(ctx, mustParse(t, artist.Id))
+	if err != nil {
+		t.Fatalf("RestoreArtist returned error: %s", err)
+	}
+	if restored != 5 {
+		t.Errorf("restored %d songs on the second attempt, want 5", restored)
+	}
+
+	songs, err := svc.ListSongsByArtist(ctx, 100, "", artist.Id)
+	if err != nil {
+		t.Fatalf("ListSongsByArtist returned error: %s", err)
+	}
+	if int(songs.Count) != count {
+		t.Errorf("artist has %d live songs, want %d", songs.Count, count)
+	}
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+
+	repository "github.com/pete-robinson/set-maker-grpc/internal/repository/ddb"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	logger "github.com/sirupsen/logrus"
+)
+
+// page size used when walking the whole catalog
+const catalogPageSize = MaxPageSize
+
+
+// Send every artist and then every song
+// The catalog is walked page by page with the same cursors as ListArtists and ListSongs, so this
+// isn't a point-in-time snapshot - writes made while it runs may or may not be included
+func (s *Service) ExportCatalog(ctx context.Context, send func(*setmakerpb.ExportCatalogItem) error) error {
+	var artists, songs int
+
+	err := s.eachArtist(ctx, func(artist *setmakerpb.Artist) error {
+		artists++
+		return send(&setmakerpb.ExportCatalogItem{
+			Item: &setmakerpb.ExportCatalogItem_Artist{Artist: artist},
+		})
+	})
+	if err != nil {
+		logger.Errorf("Could not export artists: %s", err)
+		return err
+	}
+
+	err = s.eachSong(ctx, func(song *setmakerpb.Song) error {
+		songs++
+		return send(&setmakerpb.ExportCatalogItem{
+			Item: &setmakerpb.ExportCatalogItem_Song{Song: song},
+		})
+	})
+	if err != nil {
+		logger.Errorf("Could not export songs: %s", err)
+		return err
+	}
+
+	logger.WithFields(logger.Fields{
+		"artists": artists,
+		"songs":   songs,
+	}).Info("Catalog exported")
+
+	return nil
+}
+
+
+// call fn for every artist, stopping at the first error
+func (s *Service) eachArtist(ctx context.Context, fn func(*setmakerpb.Artist) error) error {
+	cursor := ""
+	for {
+		page, err := s.repository.ListArtists(ctx, catalogPageSize, cursor)
+		if err != nil {
+			return err
+		}
+		for _, artist := range page.Items {
+			if err = fn(artist); err != nil {
+				return err
+			}
+		}
+
+		if page.Cursor == "" {
+			return nil
+		}
+		cursor = page.Cursor
+	}
+}
+
+
+// call fn for every song, stopping at the first error
+func (s *Service) eachSong(ctx context.Context, fn func(*setmakerpb.Song) error) error {
+	cursor := ""
+	for {
+		page, err := s.repository.ListSongs(ctx, catalogPageSize, cursor, repository.SongQuery{})
+		if err != nil {
+			return err
+		}
+		for _, song := range page.Items {
+			if err = fn(song); err != nil {
+				return err
+			}
+		}
+
+		if page.Cursor == "" {
+			return nil
+		}
+		cursor = page.Cursor
+	}
+}
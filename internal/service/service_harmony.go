@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pete-robinson/set-maker-grpc/internal/service/harmony"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultCompatibleLimit int32 = 50
+	MaxCompatibleLimit     int32 = 100
+)
+
+// order compatible keys are searched and returned in
+var compatibleRelations = []harmony.Relation{
+	harmony.RelationSameKey,
+	harmony.RelationRelative,
+	harmony.RelationFifthUp,
+	harmony.RelationFifthDown,
+}
+
+type CompatibleSongs struct {
+	Song    *setmakerpb.Song
+	Camelot harmony.Camelot
+	Results []*CompatibleSong
+}
+
+type CompatibleSong struct {
+	Song     *setmakerpb.Song
+	Camelot  harmony.Camelot
+	Relation harmony.Relation
+}
+
+type OrderedSongs struct {
+	Songs         []*setmakerpb.Song
+	TotalDistance int
+}
+
+
+// Find songs in keys that mix harmonically with the given song
+// Matches the same key, the relative major/minor and the keys a fifth either side
+func (s *Service) FindCompatibleSongs(ctx context.Context, id uuid.UUID, limit int32) (*CompatibleSongs, error) {
+	if limit <= 0 {
+		limit = DefaultCompatibleLimit
+	}
+	if limit > MaxCompatibleLimit {
+		limit = MaxCompatibleLimit
+	}
+
+	song, err := s.GetSong(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	camelot, err := harmony.ToCamelot(song.Key, song.Tonality)
+	if err != nil {
+		logger.WithField("song", song).Errorf("Song has no Camelot position: %s", err)
+		return nil, status.Error(codes.FailedPrecondition, "Song needs a single key and a major or minor tonality to find compatible songs")
+	}
+
+	res := &CompatibleSongs{
+		Song:    song,
+		Camelot: camelot,
+	}
+
+	// invert the compatibility map so keys can be searched in relation order
+	positions := make(map[harmony.Relation]harmony.Camelot)
+	for position, relation := range camelot.Compatible() {
+		positions[relation] = position
+	}
+
+	for _, relation := range compatibleRelations {
+		position := positions[relation]
+		key, tonality := position.Key()
+
+		remaining := limit - int32(len(res.Results))
+		if remaining <= 0 {
+			break
+		}
+
+		songs, err := s.listAllSongsByKey(ctx, key, tonality, remaining, song.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, match := range songs {
+			res.Results = append(res.Results, &CompatibleSong{
+				Song:     match,
+				Camelot:  position,
+				Relation: relation,
+			})
+		}
+	}
+
+	logger.WithFields(logger.Fields{
+		"song":         song.Id,
+		"camelot":      camelot.String(),
+		"result count": len(res.Results),
+	}).Info("Compatible songs found")
+
+	return res, nil
+}
+
+
+// page through songs in a key until max songs are found or the index is exhausted
+// the song with excludeId is skipped so a song is never compatible with itself
+func (s *Service) listAllSongsByKey(ctx context.Context, key setmakerpb.Key, tonality setmakerpb.Tonality, max int32, excludeId string) ([]*setmakerpb.Song, error) {
+	var songs []*setmakerpb.Song
+	cursor := ""
+
+	for {
+		page, err := s.repository.ListSongsByKey(ctx, max, cursor, key, tonality)
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"key":      key,
+				"tonality": tonality,
+			}).Errorf("Could not list songs by key: %s", err)
+			return nil, err
+		}
+
+		for _, song := range page.Items {
+			if song.Id == excludeId {
+				continue
+			}
+			songs = append(songs, song)
+
+			if int32(len(songs)) >= max {
+				return songs, nil
+			}
+		}
+
+		if page.Cursor == "" {
+			return songs, nil
+		}
+		cursor = page.Cursor
+	}
+}
+
+
+// Order songs so key changes between consecutive songs are as small as possible
+// openerId and closerId optionally pin a song to the start or end of the order.
+// Songs without a single major/minor key can't be placed on the wheel, so they keep their
+// relative order and are played after the keyed songs, ahead of the closer
+func (s *Service) OrderSongs(ctx context.Context, ids []string, openerId string, closerId string) (*OrderedSongs, error) {
+	if len(ids) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "At least one song is required")
+	}
+	// every permitted start is searched, so the cost grows quickly with the number of songs
+	if len(ids) > MaxBatchGetIds {
+		return nil, status.Errorf(codes.InvalidArgument, "At most %d songs can be ordered at once", MaxBatchGetIds)
+	}
+
+	// normalise pinned ids so they compare equal to stored song ids
+	for _, pinned := range []*string{&openerId, &closerId} {
+		if *pinned == "" {
+			continue
+		}
+		pinnedId, err := uuid.Parse(*pinned)
+		if err != nil {
+			logger.WithField("uuid", *pinned).Errorf("Could not parse pinned song UUID: %s", err)
+			return nil, status.Error(codes.InvalidArgument, "Invalid opener or closer Id")
+		}
+		*pinned = pinnedId.String()
+	}
+
+	if openerId != "" && openerId == closerId && len(ids) > 1 {
+		return nil, status.Error(codes.InvalidArgument, "Opener and closer must be different songs")
+	}
+
+	// parse the ids, rejecting duplicates
+	parsed := make([]uuid.UUID, 0, len(ids))
+	seen := make(map[string]bool)
+	for _, id := range ids {
+		songId, err := uuid.Parse(id)
+		if err != nil {
+			logger.WithField("uuid", id).Errorf("Could not parse song UUID: %s", err)
+			return nil, status.Error(codes.InvalidArgument, "Invalid song Id")
+		}
+
+		if seen[songId.String()] {
+			return nil, status.Errorf(codes.InvalidArgument, "Song %s appears more than once", songId)
+		}
+		seen[songId.String()] = true
+		parsed = append(parsed, songId)
+	}
+
+	// fetch the songs in one batch, keeping the order they were given in
+	found, err := s.repository.BatchGetSongs(ctx, parsed)
+	if err != nil {
+		logger.WithField("ids", ids).Errorf("Could not fetch songs to order: %s", err)
+		return nil, err
+	}
+
+	songs := make([]*setmakerpb.Song, 0, len(parsed))
+	for _, id := range parsed {
+		song, ok := found[id.String()]
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "Song %s not found", id)
+		}
+		songs = append(songs, song)
+	}
+
+	if openerId != "" && !seen[openerId] {
+		return nil, status.Error(codes.InvalidArgument, "Opener must be one of the songs being ordered")
+	}
+	if closerId != "" && !seen[closerId] {
+		return nil, status.Error(codes.InvalidArgument, "Closer must be one of the songs being ordered")
+	}
+
+	// split songs into those with a position on the wheel and those without
+	var keyed, unkeyed []*setmakerpb.Song
+	var positions []harmony.Camelot
+	var opener, closer *setmakerpb.Song
+	pinOpener, pinCloser := harmony.Unpinned, harmony.Unpinned
+
+	for _, song := range songs {
+		position, err := harmony.ToCamelot(song.Key, song.Tonality)
+		if err != nil {
+			switch song.Id {
+			case openerId:
+				opener = song
+			case closerId:
+				closer = song
+			default:
+				unkeyed = append(unkeyed, song)
+			}
+			continue
+		}
+
+		switch song.Id {
+		case openerId:
+			pinOpener = len(keyed)
+		case closerId:
+			pinCloser = len(keyed)
+		}
+
+		keyed = append(keyed, song)
+		positions = append(positions, position)
+	}
+
+	order := harmony.Order(positions, pinOpener, pinCloser)
+
+	// assemble the final order around the keyed songs
+	res := &OrderedSongs{
+		TotalDistance: harmony.PathDistance(positions, order),
+	}
+	if opener != nil {
+		res.Songs = append(res.Songs, opener)
+	}
+	for _, i := range order {
+		if i == pinCloser {
+			continue
+		}
+		res.Songs = append(res.Songs, keyed[i])
+	}
+	res.Songs = append(res.Songs, unkeyed...)
+	if pinCloser != harmony.Unpinned {
+		res.Songs = append(res.Songs, keyed[pinCloser])
+	}
+	if closer != nil {
+		res.Songs = append(res.Songs, closer)
+	}
+
+	logger.WithFields(logger.Fields{
+		"songs": len(res.Songs),
+		"unkeyed": len(unkeyed),
+		"distance": res.TotalDistance,
+	}).Info("Songs ordered")
+
+	return res, nil
+}
@@ -0,0 +1,121 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/pete-robinson/set-maker-grpc/internal/service"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	"google.golang.org/grpc/codes"
+)
+
+func TestOrderSongsMinimisesKeyChanges(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := tenantContext("a")
+
+	artist := createArtist(t, ctx, svc, "Artist")
+	d := createSong(t, ctx, svc, artist.Id, "D", setmakerpb.Key_KEY_D, setmakerpb.Tonality_TONALITY_MAJOR)
+	c := createSong(t, ctx, svc, artist.Id, "C", setmakerpb.Key_KEY_C, setmakerpb.Tonality_TONALITY_MAJOR)
+	g := createSong(t, ctx, svc, artist.Id, "G", setmakerpb.Key_KEY_G, setmakerpb.Tonality_TONALITY_MAJOR)
+
+	res, err := svc.OrderSongs(ctx, []string{d.Id, c.Id, g.Id}, "", "")
+	if err != nil {
+		t.Fatalf("OrderSongs returned error: %s", err)
+	}
+
+	if res.TotalDistance != 2 {
+		t.Errorf("TotalDistance = %d, want 2", res.TotalDistance)
+	}
+	if len(res.Songs) != 3 || res.Songs[1].Id != g.Id {
+		t.Errorf("order = %v, want G between C and D", titles(res.Songs))
+	}
+}
+
+
+func TestOrderSongsKeepsPinnedSongs(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := tenantContext("a")
+
+	artist := createArtist(t, ctx, svc, "Artist")
+	c := createSong(t, ctx, svc, artist.Id, "C", setmakerpb.Key_KEY_C, setmakerpb.Tonality_TONALITY_MAJOR)
+	g := createSong(t, ctx, svc, artist.Id, "G", setmakerpb.Key_KEY_G, setmakerpb.Tonality_TONALITY_MAJOR)
+	d := createSong(t, ctx, svc, artist.Id, "D", setmakerpb.Key_KEY_D, setmakerpb.Tonality_TONALITY_MAJOR)
+	am := createSong(t, ctx, svc, artist.Id, "Am", setmakerpb.Key_KEY_A, setmakerpb.Tonality_TONALITY_MINOR)
+	em := createSong(t, ctx, svc, artist.Id, "Em", setmakerpb.Key_KEY_E, setmakerpb.Tonality_TONALITY_MINOR)
+
+	ids := []string{c.Id, g.Id, d.Id, am.Id, em.Id}
+	res, err := svc.OrderSongs(ctx, ids, d.Id, am.Id)
+	if err != nil {
+		t.Fatalf("OrderSongs returned error: %s", err)
+	}
+
+	if len(res.Songs) != len(ids) {
+		t.Fatalf("got %d songs, want %d", len(res.Songs), len(ids))
+	}
+	if res.Songs[0].Id != d.Id {
+		t.Errorf("opener = %s, want D", res.Songs[0].Title)
+	}
+	if res.Songs[len(res.Songs)-1].Id != am.Id {
+		t.Errorf("closer = %s, want Am", res.Songs[len(res.Songs)-1].Title)
+	}
+
+	seen := make(map[string]bool)
+	for _, song := range res.Songs {
+		seen[song.Id] = true
+	}
+	for _, id := range ids {
+		if !seen[id] {
+			t.Errorf("song %s missing from the order %v", id, titles(res.Songs))
+		}
+	}
+}
+
+
+func TestOrderSongsRejectsBadInput(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := tenantContext("a")
+
+	artist := createArtist(t, ctx, svc, "Artist")
+	c := createSong(t, ctx, svc, artist.Id, "C", setmakerpb.Key_KEY_C, setmakerpb.Tonality_TONALITY_MAJOR)
+	g := createSong(t, ctx, svc, artist.Id, "G", setmakerpb.Key_KEY_G, setmakerpb.Tonality_TONALITY_MAJOR)
+	other := createSong(t, ctx, svc, artist.Id, "Other", setmakerpb.Key_KEY_D, setmakerpb.Tonality_TONALITY_MAJOR)
+
+	tooMany := make([]string, service.MaxBatchGetIds+1)
+	for i := range tooMany {
+		tooMany[i] = uuid.New().String()
+	}
+
+	tests := []struct {
+		name   string
+		ids    []string
+		opener string
+		closer string
+		want   codes.Code
+	}{
+		{"no songs", nil, "", "", codes.InvalidArgument},
+		{"too many songs", tooMany, "", "", codes.InvalidArgument},
+		{"invalid id", []string{c.Id, "nope"}, "", "", codes.InvalidArgument},
+		{"duplicate", []string{c.Id, g.Id, c.Id}, "", "", codes.InvalidArgument},
+		{"opener is closer", []string{c.Id, g.Id}, c.Id, c.Id, codes.InvalidArgument},
+		{"opener not ordered", []string{c.Id, g.Id}, other.Id, "", codes.InvalidArgument},
+		{"closer not ordered", []string{c.Id, g.Id}, "", other.Id, codes.InvalidArgument},
+		{"unknown song", []string{c.Id, uuid.New().String()}, "", "", codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.OrderSongs(ctx, tt.ids, tt.opener, tt.closer)
+			assertCode(t, err, tt.want)
+		})
+	}
+}
+
+
+func titles(songs []*setmakerpb.Song) []string {
+	res := make([]string, 0, len(songs))
+	for _, song := range songs {
+		res = append(res, song.Title)
+	}
+
+	return res
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	repository "github.com/pete-robinson/set-maker-grpc/internal/repository/ddb"
+	"github.com/pete-robinson/set-maker-grpc/internal/service/validation"
+	"github.com/pete-robinson/set-maker-grpc/internal/utils"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// max number of rows accepted by a single import
+const MaxImportRows = 10000
+
+// Outcome of importing one row
+// Error is empty when the row was imported
+type ImportRowResult struct {
+	Row      int32
+	SongId   string
+	ArtistId string
+	Error    string
+}
+
+type ImportReport struct {
+	Results        []*ImportRowResult
+	Imported       int32
+	Failed         int32
+	ArtistsCreated int32
+}
+
+
+// Import songs in bulk, matching artists by name and creating any that don't exist
+// Rows are validated and their artists resolved in order, then songs are written with their events
+// in transactions of repository.SongsPerTransaction. A failed row doesn't stop the import - every row
+// gets its own result
+func (s *Service) ImportCatalog(ctx context.Context, rows []*setmakerpb.ImportCatalogRow) (*ImportReport, error) {
+	if len(rows) > MaxImportRows {
+		return nil, status.Errorf(codes.InvalidArgument, "An import can contain at most %d rows", MaxImportRows)
+	}
+
+	logger.WithField("rows", len(rows)).Info("Importing catalog")
+
+	report := &ImportReport{
+		Results: make([]*ImportRowResult, len(rows)),
+	}
+
+	// normalised artist name to artist ID, so each artist is only looked up once
+	artists := make(map[string]string)
+
+	var batch []*setmakerpb.Song
+	var batchResults []*ImportRowResult
+	for i, row := range rows {
+		result := &ImportRowResult{Row: int32(i + 1)}
+		report.Results[i] = result
+
+		if err := validation.ValidateImportRow(row); err != nil {
+			result.Error = describeError(err)
+			continue
+		}
+
+		artistId, created, err := s.resolveArtist(ctx, row.ArtistName, artists)
+		if err != nil {
+			result.Error = describeError(err)
+			continue
+		}
+		if created {
+			report.ArtistsCreated++
+		}
+		result.ArtistId = artistId
+
+		// init UUID and meta
+		song := &setmakerpb.Song{
+			Id:       uuid.New().String(),
+			Title:    row.Title,
+			ArtistId: artistId,
+			Key:      row.Key,
+			Tonality: row.Tonality,
+			Metadata: &setmakerpb.Metadata{},
+		}
+		utils.SetMetaData(ctx, song.Metadata, s.clock())
+
+		batch = append(batch, song)
+		batchResults = append(batchResults, result)
+		if len(batch) == repository.SongsPerTransaction {
+			s.importSongs(ctx, batch, batchResults)
+			batch, batchResults = nil, nil
+		}
+	}
+
+	if len(batch) > 0 {
+		s.importSongs(ctx, batch, batchResults)
+	}
+
+	for _, result := range report.Results {
+		if result.Error == "" {
+			report.Imported++
+		} else {
+			report.Failed++
+		}
+	}
+
+	logger.WithFields(logger.Fields{
+		"imported":       report.Imported,
+		"failed":         report.Failed,
+		"artistsCreated": report.ArtistsCreated,
+	}).Info("Catalog imported")
+
+	return report, nil
+}
+
+
+// write a batch of imported songs and record the outcome against their rows
+func (s *Service) importSongs(ctx context.Context, songs []*setmakerpb.Song, results []*ImportRowResult) {
+	events := make([]*setmakerpb.Event, 0, len(songs))
+	for _, song := range songs {
+		events = append(events, newSongCreatedEvent(song))
+	}
+
+	if err := s.repository.PutSongs(ctx, songs, events); err != nil {
+		logger.WithField("count", len(songs)).Errorf("Could not import songs: %s", err)
+		for _, result := range results {
+			result.Error = describeError(err)
+		}
+		return
+	}
+
+	for i, song := range songs {
+		results[i].SongId = song.Id
+		s.indexSong(ctx, song)
+	}
+}
+
+
+// find an artist by name, creating it if there isn't one
+// the name must already have been validated
+// returns the artist ID and whether the artist was created
+func (s *Service) resolveArtist(ctx context.Context, name string, known map[string]string) (string, bool, error) {
+	artist := &setmakerpb.Artist{Name: strings.TrimSpace(name)}
+
+	normalised := utils.NormaliseName(artist.Name)
+	if id, ok := known[normalised]; ok {
+		return id, false, nil
+	}
+
+	existing, err := s.repository.GetArtistByName(ctx, artist.Name)
+	if err == nil {
+		known[normalised] = existing.Id
+		return existing.Id, false, nil
+	}
+	if status.Code(err) != codes.NotFound {
+		return "", false, err
+	}
+
+	created, err := s.CreateArtist(ctx, artist)
+	if status.Code(err) == codes.AlreadyExists {
+		// created by someone else since the lookup
+		existing, err = s.repository.GetArtistByName(ctx, artist.Name)
+		if err != nil {
+			return "", false, err
+		}
+		known[normalised] = existing.Id
+		return existing.Id, false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+
+	known[normalised] = created.Id
+	return created.Id, true, nil
+}
+
+
+// describe an error for a per-row report, including any field violations it carries
+func describeError(err error) string {
+	st := status.Convert(err)
+
+	var violations []string
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range badRequest.FieldViolations {
+				violations = append(violations, v.Field+" "+v.Description)
+			}
+		}
+	}
+
+	if len(violations) == 0 {
+		return st.Message()
+	}
+
+	return st.Message() + ": " + strings.Join(violations, "; ")
+}
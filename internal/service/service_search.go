@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pete-robinson/set-maker-grpc/internal/service/search"
+	"github.com/pete-robinson/set-maker-grpc/internal/tenant"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultSearchLimit int32 = 20
+	MaxSearchLimit     int32 = 100
+	// age at which a tenant's search index is rebuilt from the repository, picking up
+	// changes made through other servers
+	SearchIndexRefresh = 5 * time.Minute
+	// how long a background rebuild of a search index may take
+	searchRebuildTimeout = time.Minute
+)
+
+type SearchResults struct {
+	Artists []*setmakerpb.Artist
+	Songs   []*setmakerpb.Song
+}
+
+
+// Search the tenant's artist names and song titles
+// Terms match anywhere in a word, ignoring case and accents, and every term must match
+func (s *Service) Search(ctx context.Context, query string, limit int32) (*SearchResults, error) {
+	if len(search.Tokenise(query)) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Search query must contain at least one word")
+	}
+
+	tenantId, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.loadSearchIndex(ctx, tenantId); err != nil {
+		return nil, status.Error(codes.Internal, "Could not load search index")
+	}
+
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+	if limit > MaxSearchLimit {
+		limit = MaxSearchLimit
+	}
+
+	artists, err := fetchHits(s.search.Search(query, tenantId, search.KindArtist, 0), int(limit), func(ids []uuid.UUID) (map[string]*setmakerpb.Artist, error) {
+		return s.repository.BatchGetArtists(ctx, ids)
+	}, func(id string) {
+		s.search.Remove(tenantId, search.KindArtist, id)
+	})
+	if err != nil {
+		logger.WithField("query", query).Errorf("Could not fetch artists for search: %s", err)
+		return nil, err
+	}
+
+	songs, err := fetchHits(s.search.Search(query, tenantId, search.KindSong, 0), int(limit), func(ids []uuid.UUID) (map[string]*setmakerpb.Song, error) {
+		return s.repository.BatchGetSongs(ctx, ids)
+	}, func(id string) {
+		s.search.Remove(tenantId, search.KindSong, id)
+	})
+	if err != nil {
+		logger.WithField("query", query).Errorf("Could not fetch songs for search: %s", err)
+		return nil, err
+	}
+
+	res := &SearchResults{Artists: artists, Songs: songs}
+
+	logger.WithFields(logger.Fields{
+		"query":   query,
+		"artists": len(res.Artists),
+		"songs":   len(res.Songs),
+	}).Info("Search complete")
+
+	return res, nil
+}
+
+
+// fetch the records for ranked hits in batches, in rank order, until limit are found or the hits run out
+// a hit with no record was deleted since it was indexed, so is passed to missing and skipped
+func fetchHits[T any](hits []search.Result, limit int, fetch func([]uuid.UUID) (map[string]T, error), missing func(string)) ([]T, error) {
+	var res []T
+	for len(hits) > 0 && len(res) < limit {
+		batch := hits
+		if len(batch) > limit-len(res) {
+			batch = batch[:limit-len(res)]
+		}
+		hits = hits[len(batch):]
+
+		ids := make([]uuid.UUID, 0, len(batch))
+		for _, hit := range batch {
+			ids = append(ids, uuid.MustParse(hit.Id))
+		}
+
+		found, err := fetch(ids)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, hit := range batch {
+			record, ok := found[hit.Id]
+			if !ok {
+				missing(hit.Id)
+				continue
+			}
+			res = append(res, record)
+		}
+	}
+
+	return res, nil
+}
+
+
+// Load every one of a tenant's artists and songs into the search index
+// The index lives in memory, so this runs the first time the tenant searches. Later changes made
+// through this server are indexed as they're made, but changes made through other servers aren't,
+// so an index older than SearchIndexRefresh is rebuilt in the background while searches carry on
+// against the one there is
+func (s *Service) loadSearchIndex(ctx context.Context, tenantId string) error {
+	now := s.clock()
+	if s.search.LoadedAt(tenantId).IsZero() {
+		return s.rebuildSearchIndex(ctx, tenantId, now)
+	}
+
+	if s.search.StartRefresh(tenantId, SearchIndexRefresh, now) {
+		go func() {
+			// runs apart from the search that started it, so it isn't cut short when that ends
+			ctx, cancel := context.WithTimeout(tenant.NewContext(context.Background(), tenantId), searchRebuildTimeout)
+			defer cancel()
+
+			if err := s.rebuildSearchIndex(ctx, tenantId, now); err != nil {
+				s.search.RefreshFailed(tenantId)
+			}
+		}()
+	}
+
+	return nil
+}
+
+
+// read a tenant's whole catalog and swap it into the search index
+// two first searches racing both load the catalog, which is harmless
+func (s *Service) rebuildSearchIndex(ctx context.Context, tenantId string, at time.Time) error {
+	var docs []search.Document
+
+	err := s.eachArtist(ctx, func(artist *setmakerpb.Artist) error {
+		docs = append(docs, artistDocument(tenantId, artist))
+		return nil
+	})
+	if err != nil {
+		logger.Errorf("Could not list artists for search index: %s", err)
+		return err
+	}
+
+	err = s.eachSong(ctx, func(song *setmakerpb.Song) error {
+		docs = append(docs, songDocument(tenantId, song))
+		return nil
+	})
+	if err != nil {
+		logger.Errorf("Could not list songs for search index: %s", err)
+		return err
+	}
+
+	s.search.Load(tenantId, docs, at)
+
+	logger.WithFields(logger.Fields{
+		"tenant":    tenantId,
+		"documents": len(docs),
+	}).Info("Search index loaded for tenant")
+	return nil
+}
+
+
+// the documents of the tenant a request acts for. the repository has already refused
+// any write made without one
+func (s *Service) indexArtist(ctx context.Context, artist *setmakerpb.Artist) {
+	tenantId, _ := tenant.FromContext(ctx)
+	s.search.Put(artistDocument(tenantId, artist))
+}
+
+
+func (s *Service) indexSong(ctx context.Context, song *setmakerpb.Song) {
+	tenantId, _ := tenant.FromContext(ctx)
+	s.search.Put(songDocument(tenantId, song))
+}
+
+
+func artistDocument(tenantId string, artist *setmakerpb.Artist) search.Document {
+	return search.Document{
+		Tenant: tenantId,
+		Kind:   search.KindArtist,
+		Id:     artist.Id,
+		Text:   artist.Name,
+	}
+}
+
+
+func songDocument(tenantId string, song *setmakerpb.Song) search.Document {
+	return search.Document{
+		Tenant: tenantId,
+		Kind:   search.KindSong,
+		Id:     song.Id,
+		Text:   song.Title,
+	}
+}
+
+
+func (s *Service) unindex(ctx context.Context, kind search.Kind, id string) {
+	tenantId, _ := tenant.FromContext(ctx)
+	s.search.Remove(tenantId, kind, id)
+}
@@ -0,0 +1,76 @@
+package service_test
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/pete-robinson/set-maker-grpc/internal/service"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+)
+
+func TestSearchFillsTheLimitPastDeletedHits(t *testing.T) {
+	svc, repo := newTestService(t)
+	// a second server over the same repository, with its own index
+	other := service.NewService(repo)
+	ctx := tenantContext("a")
+
+	artists := []*setmakerpb.Artist{
+		createArtist(t, ctx, svc, "Band One"),
+		createArtist(t, ctx, svc, "Band Two"),
+		createArtist(t, ctx, svc, "Band Three"),
+	}
+	if _, err := svc.Search(ctx, "band", 10); err != nil {
+		t.Fatalf("Search returned error: %s", err)
+	}
+
+	// equally good hits rank by id, so delete the two that rank first behind this server's back
+	sort.Slice(artists, func(a, b int) bool { return artists[a].Id < artists[b].Id })
+	for _, artist := range artists[:2] {
+		if _, err := other.DeleteArtist(ctx, mustParse(t, artist.Id), restrict); err != nil {
+			t.Fatalf("DeleteArtist returned error: %s", err)
+		}
+	}
+
+	res, err := svc.Search(ctx, "band", 1)
+	if err != nil {
+		t.Fatalf("Search returned error: %s", err)
+	}
+	if len(res.Artists) != 1 || res.Artists[0].Id != artists[2].Id {
+		t.Errorf("found %v, want only %s", res.Artists, artists[2].Name)
+	}
+}
+
+
+func TestSearchPicksUpOtherServersChanges(t *testing.T) {
+	svc, repo := newTestService(t)
+	other := service.NewService(repo)
+	ctx := tenantContext("a")
+
+	now := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	svc.SetClock(func() time.Time {
+		return now
+	})
+
+	if _, err := svc.Search(ctx, "band", 10); err != nil {
+		t.Fatalf("Search returned error: %s", err)
+	}
+	created := createArtist(t, ctx, other, "Band")
+
+	// once the index is old enough it is rebuilt in the background
+	now = now.Add(service.SearchIndexRefresh)
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		res, err := svc.Search(ctx, "band", 10)
+		if err != nil {
+			t.Fatalf("Search returned error: %s", err)
+		}
+		if len(res.Artists) == 1 && res.Artists[0].Id == created.Id {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("search never found the artist created through another server, found %v", res.Artists)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
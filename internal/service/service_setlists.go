@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	repository "github.com/pete-robinson/set-maker-grpc/internal/repository/ddb"
+	"github.com/pete-robinson/set-maker-grpc/internal/utils"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// layout expected for setlist performance dates
+const SetlistDateLayout = "2006-01-02"
+
+
+func (s *Service) ListSetlists(ctx context.Context, limit int32, cursor string) (*repository.SetlistList, error) {
+	res, err := s.repository.ListSetlists(ctx, pageSize(limit), cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.WithFields(logger.Fields{
+		"result count": res.Count,
+		"cursor": res.Cursor,
+	}).Info("Results found")
+
+	return res, nil
+}
+
+
+func (s *Service) GetSetlist(ctx context.Context, id uuid.UUID) (*setmakerpb.Setlist, error) {
+	setlist, err := s.repository.GetSetlist(ctx, id)
+	if err != nil {
+		logger.WithField("id", id).Errorf("Could not fetch setlist: %s", err)
+		return nil, err
+	}
+
+	return setlist, nil
+}
+
+
+func (s *Service) CreateSetlist(ctx context.Context, setlist *setmakerpb.Setlist) (*setmakerpb.Setlist, error) {
+	if err := s.validateSetlist(ctx, setlist); err != nil {
+		return nil, err
+	}
+
+	// init UUID and meta
+	setlist.Id = uuid.New().String()
+	setlist.Metadata = &setmakerpb.Metadata{}
+	utils.SetMetaData(ctx, setlist.Metadata, s.clock())
+
+	if err := s.repository.PutSetlist(ctx, setlist); err != nil {
+		logger.WithField("data", setlist).Errorf("Could not create setlist: %s", err)
+		return nil, err
+	}
+
+	return setlist, nil
+}
+
+
+func (s *Service) UpdateSetlist(ctx context.Context, setlist *setmakerpb.Setlist) (*setmakerpb.Setlist, error) {
+	// fetch the setlist to update
+	targetId, err := uuid.Parse(setlist.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Error fetching setlist to update")
+	}
+
+	target, err := s.GetSetlist(ctx, targetId)
+	if target == nil {
+		return nil, status.Error(codes.NotFound, "Setlist to update does not exist")
+	}
+
+	if err = s.validateSetlist(ctx, setlist); err != nil {
+		return nil, err
+	}
+
+	setlist.Metadata = target.Metadata
+	utils.SetMetaData(ctx, setlist.Metadata, s.clock())
+
+	// update setlist
+	if err = s.repository.PutSetlist(ctx, setlist); err != nil {
+		return nil, err
+	}
+
+	return setlist, nil
+}
+
+
+func (s *Service) DeleteSetlist(ctx context.Context, id uuid.UUID) error {
+	if err := s.repository.DeleteSetlist(ctx, id); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+
+// check the performance date parses and every slot references a known song
+func (s *Service) validateSetlist(ctx context.Context, setlist *setmakerpb.Setlist) error {
+	if setlist.Date != "" {
+		if _, err := time.Parse(SetlistDateLayout, setlist.Date); err != nil {
+			logger.WithField("date", setlist.Date).Errorf("Could not parse setlist date: %s", err)
+			return status.Error(codes.InvalidArgument, "Setlist date must be in YYYY-MM-DD format")
+		}
+	}
+
+	ids := make([]uuid.UUID, 0, len(setlist.Slots))
+	for _, slot := range setlist.Slots {
+		songId, err := uuid.Parse(slot.SongId)
+		if err != nil {
+			logger.WithField("uuid", slot.SongId).Errorf("Could not parse song UUID: %s", err)
+			return status.Error(codes.InvalidArgument, "Invalid song Id in setlist")
+		}
+		ids = append(ids, songId)
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// check every song exists in one round trip
+	found, err := s.repository.BatchGetSongs(ctx, ids)
+	if err != nil {
+		logger.WithField("ids", ids).Errorf("Error fetching songs for setlist: %s", err)
+		return err
+	}
+
+	for _, id := range ids {
+		if _, ok := found[id.String()]; !ok {
+			logger.WithField("songId", id).Error("Error locating song for setlist")
+			return status.Errorf(codes.NotFound, "Song %s not found", id)
+		}
+	}
+
+	return nil
+}
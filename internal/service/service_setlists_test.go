@@ -0,0 +1,43 @@
+package service_test
+
+import (
+	"testing"
+
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	"google.golang.org/grpc/codes"
+)
+
+func TestPutSetlistRejectsAStaleWrite(t *testing.T) {
+	svc, repo := newTestService(t)
+	ctx := tenantContext("a")
+
+	setlist, err := svc.CreateSetlist(ctx, &setmakerpb.Setlist{Name: "Friday"})
+	if err != nil {
+		t.Fatalf("CreateSetlist returned error: %s", err)
+	}
+
+	// read, then lose a race with another update
+	stale, err := svc.GetSetlist(ctx, mustParse(t, setlist.Id))
+	if err != nil {
+		t.Fatalf("GetSetlist returned error: %s", err)
+	}
+	updated, err := svc.UpdateSetlist(ctx, &setmakerpb.Setlist{Id: setlist.Id, Name: "Winner"})
+	if err != nil {
+		t.Fatalf("UpdateSetlist returned error: %s", err)
+	}
+	if updated.Metadata.Version != setlist.Metadata.Version+1 {
+		t.Errorf("version = %d, want %d after an update", updated.Metadata.Version, setlist.Metadata.Version+1)
+	}
+
+	stale.Name = "Loser"
+	stale.Metadata.Version++
+	assertCode(t, repo.PutSetlist(ctx, stale), codes.Aborted)
+
+	stored, err := svc.GetSetlist(ctx, mustParse(t, setlist.Id))
+	if err != nil {
+		t.Fatalf("GetSetlist returned error: %s", err)
+	}
+	if stored.Name != "Winner" {
+		t.Errorf("name = %q, the stale write should not have been written", stored.Name)
+	}
+}
@@ -5,16 +5,44 @@ import (
 
 	"github.com/google/uuid"
 	repository "github.com/pete-robinson/set-maker-grpc/internal/repository/ddb"
+	"github.com/pete-robinson/set-maker-grpc/internal/service/search"
+	"github.com/pete-robinson/set-maker-grpc/internal/service/validation"
 	"github.com/pete-robinson/set-maker-grpc/internal/utils"
 	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
 	logger "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 
-func (s *Service) ListSongs(ctx context.Context, limit int32, cursor string) (*repository.SongList, error) {
-	res, err := s.repository.ListSongs(ctx, limit, cursor)
+type BatchSongs struct {
+	Songs      []*setmakerpb.Song
+	MissingIds []string
+}
+
+
+func (s *Service) ListSongs(ctx context.Context, req *setmakerpb.ListSongsRequest) (*repository.SongList, error) {
+	if err := validation.ValidateListSongs(req); err != nil {
+		return nil, err
+	}
+
+	query := repository.SongQuery{
+		Key: req.Key,
+		Tonality: req.Tonality,
+		ArtistId: req.ArtistId,
+		OrderBy: req.OrderBy,
+		Descending: req.Descending,
+	}
+	if req.CreatedAfter != nil {
+		query.CreatedAfter = req.CreatedAfter.AsTime()
+	}
+	if req.CreatedBefore != nil {
+		query.CreatedBefore = req.CreatedBefore.AsTime()
+	}
+
+	res, err := s.repository.ListSongs(ctx, pageSize(req.Limit), req.Cursor, query)
 	if err != nil {
 		return nil, err
 	}
@@ -41,7 +69,7 @@ func (s *Service) ListSongsByArtist(ctx context.Context, limit int32, cursor str
 		return nil, status.Error(codes.NotFound, "Unknown artist")
 	}
 
-	res, err := s.repository.ListSongsByArtist(ctx, limit, cursor, artistId)
+	res, err := s.repository.ListSongsByArtist(ctx, pageSize(limit), cursor, artistId)
 	if err != nil {
 		return nil, err
 	}
@@ -67,7 +95,77 @@ func (s *Service) GetSong(ctx context.Context, id uuid.UUID) (*setmakerpb.Song,
 }
 
 
+// Get a set of songs in the order requested
+// repeated ids are returned once, at their first position. ids with no song are listed as missing
+func (s *Service) BatchGetSongs(ctx context.Context, ids []string) (*BatchSongs, error) {
+	parsed, err := parseBatchIds(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := s.repository.BatchGetSongs(ctx, parsed)
+	if err != nil {
+		logger.WithField("ids", ids).Errorf("Could not fetch songs: %s", err)
+		return nil, err
+	}
+
+	res := &BatchSongs{}
+	for _, id := range parsed {
+		if song, ok := found[id.String()]; ok {
+			res.Songs = append(res.Songs, song)
+		} else {
+			res.MissingIds = append(res.MissingIds, id.String())
+		}
+	}
+
+	return res, nil
+}
+
+
+// Fill in the artist summary of each song
+// artists are fetched in one batched lookup, once per distinct artist. songs whose artist no
+// longer exists are left without a summary
+func (s *Service) ExpandArtists(ctx context.Context, songs ...*setmakerpb.Song) error {
+	seen := make(map[uuid.UUID]bool)
+	var ids []uuid.UUID
+	for _, song := range songs {
+		id, err := uuid.Parse(song.ArtistId)
+		if err != nil || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	artists, err := s.repository.BatchGetArtists(ctx, ids)
+	if err != nil {
+		logger.WithField("artistIds", ids).Errorf("Could not expand artists: %s", err)
+		return err
+	}
+
+	for _, song := range songs {
+		if artist, ok := artists[song.ArtistId]; ok {
+			song.Artist = &setmakerpb.ArtistSummary{
+				Id: artist.Id,
+				Name: artist.Name,
+				Image: artist.Image,
+			}
+		}
+	}
+
+	return nil
+}
+
+
 func (s *Service) CreateSong(ctx context.Context, song *setmakerpb.Song) (*setmakerpb.Song, error) {
+	if err := validation.ValidateSong(song, SongUpdatePaths); err != nil {
+		return nil, err
+	}
+
 	// artistID to uuid
 	artistId, err := uuid.Parse(song.ArtistId);
 	if err != nil {
@@ -84,28 +182,29 @@ func (s *Service) CreateSong(ctx context.Context, song *setmakerpb.Song) (*setma
 	// init UUID and meta
 	song.Id = uuid.New().String()
 	song.Metadata = &setmakerpb.Metadata{}
-	utils.SetMetaData(song.Metadata)
+	utils.SetMetaData(ctx, song.Metadata, s.clock())
 
-	if err := s.repository.PutSong(ctx, song); err != nil {
+	if err := s.repository.PutSong(ctx, song, newSongCreatedEvent(song)); err != nil {
 		logger.WithField("data", song).Errorf("Could not create song: %s", err)
 		return nil, err
 	}
+	s.indexSong(ctx, song)
 
 	return song, nil
 }
 
 
-func (s *Service) UpdateSong(ctx context.Context, song *setmakerpb.Song) (*setmakerpb.Song, error) {
-	// artistID to uuid
-	artistId, err := uuid.Parse(song.ArtistId);
+// Update a song
+// Only the fields named in mask are changed, or every field if the mask is empty.
+// If expectedVersion is set the update is rejected unless it matches the stored version.
+// Either way the write fails with Aborted if the song changes between being read and written
+func (s *Service) UpdateSong(ctx context.Context, song *setmakerpb.Song, mask *fieldmaskpb.FieldMask, expectedVersion int64) (*setmakerpb.Song, error) {
+	paths, err := maskPaths(mask, SongUpdatePaths)
 	if err != nil {
-		logger.WithField("uuid", song.ArtistId).Errorf("Could not parse artist UUID: %s", err)
-		return nil, status.Error(codes.InvalidArgument, "Invalid artist Id")
+		return nil, err
 	}
 
-	// validate the artist exists
-	if _, err := s.GetArtist(ctx, artistId); err != nil {
-		logger.WithField("artistId", artistId).Errorf("Error locating artist for song: %s", err)
+	if err = validation.ValidateSong(song, paths); err != nil {
 		return nil, err
 	}
 
@@ -120,22 +219,54 @@ func (s *Service) UpdateSong(ctx context.Context, song *setmakerpb.Song) (*setma
 		return nil, status.Error(codes.NotFound, "Song to update does not exist")
 	}
 
-	song.Metadata = target.Metadata
-	utils.SetMetaData(song.Metadata)
+	if err = checkExpectedVersion(target.Metadata, expectedVersion); err != nil {
+		return nil, err
+	}
 
-	// update artist
-	if err = s.repository.PutSong(ctx, song); err != nil {
+	before := proto.Clone(target).(*setmakerpb.Song)
+	applySongMask(target, song, paths)
+
+	// artistID to uuid
+	artistId, err := uuid.Parse(target.ArtistId);
+	if err != nil {
+		logger.WithField("uuid", target.ArtistId).Errorf("Could not parse artist UUID: %s", err)
+		return nil, status.Error(codes.InvalidArgument, "Invalid artist Id")
+	}
+
+	// validate the artist exists
+	if _, err := s.GetArtist(ctx, artistId); err != nil {
+		logger.WithField("artistId", artistId).Errorf("Error locating artist for song: %s", err)
 		return nil, err
 	}
 
-	return song, nil
+	utils.SetMetaData(ctx, target.Metadata, s.clock())
+
+	// update song
+	if err = s.repository.PutSong(ctx, target, newSongUpdatedEvent(before, target)); err != nil {
+		return nil, err
+	}
+	s.indexSong(ctx, target)
+
+	return target, nil
 }
 
 
+// Delete a song
+// The song is moved to the trash and can be restored with RestoreSong
 func (s *Service) DeleteSong(ctx context.Context, id uuid.UUID) error {
-	if err := s.repository.DeleteSong(ctx, id); err != nil {
+	// snapshot the song for the deleted event
+	song, err := s.GetSong(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	target := proto.Clone(song).(*setmakerpb.Song)
+	utils.SetDeleted(ctx, target.Metadata, s.clock())
+
+	if err := s.repository.PutSong(ctx, target, newSongDeletedEvent(song)); err != nil {
 		return err
 	}
+	s.unindex(ctx, search.KindSong, song.Id)
 
 	return nil
 }
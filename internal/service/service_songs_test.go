@@ -0,0 +1,187 @@
+package service_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pete-robinson/set-maker-grpc/internal/service"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestUpdateSongChecksExpectedVersion(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := tenantContext("a")
+
+	artist := createArtist(t, ctx, svc, "Artist")
+	song := createSong(t, ctx, svc, artist.Id, "Title", setmakerpb.Key_KEY_C, setmakerpb.Tonality_TONALITY_MAJOR)
+	version := song.Metadata.Version
+
+	update := &setmakerpb.Song{Id: song.Id, Title: "Renamed", ArtistId: artist.Id, Key: song.Key, Tonality: song.Tonality}
+	updated, err := svc.UpdateSong(ctx, update, nil, version)
+	if err != nil {
+		t.Fatalf("UpdateSong at the current version returned error: %s", err)
+	}
+	if updated.Metadata.Version != version+1 {
+		t.Errorf("version = %d, want %d", updated.Metadata.Version, version+1)
+	}
+
+	// a second client still holding the original version
+	update.Title = "Stale"
+	_, err = svc.UpdateSong(ctx, update, nil, version)
+	assertCode(t, err, codes.Aborted)
+
+	stored, err := svc.GetSong(ctx, mustParse(t, song.Id))
+	if err != nil {
+		t.Fatalf("GetSong returned error: %s", err)
+	}
+	if stored.Title != "Renamed" {
+		t.Errorf("title = %q, the stale update should not have been written", stored.Title)
+	}
+}
+
+
+func TestPutSongRejectsAStaleWrite(t *testing.T) {
+	svc, repo := newTestService(t)
+	ctx := tenantContext("a")
+
+	artist := createArtist(t, ctx, svc, "Artist")
+	song := createSong(t, ctx, svc, artist.Id, "Title", setmakerpb.Key_KEY_C, setmakerpb.Tonality_TONALITY_MAJOR)
+
+	// read, then lose a race with another update
+	stale, err := svc.GetSong(ctx, mustParse(t, song.Id))
+	if err != nil {
+		t.Fatalf("GetSong returned error: %s", err)
+	}
+	if _, err = svc.UpdateSong(ctx, &setmakerpb.Song{Id: song.Id, Title: "Winner"}, mask("title"), 0); err != nil {
+		t.Fatalf("UpdateSong returned error: %s", err)
+	}
+
+	stale.Title = "Loser"
+	stale.Metadata.Version++
+	assertCode(t, repo.PutSong(ctx, stale), codes.Aborted)
+}
+
+
+func TestListSongsOrderedByTitleAcrossPages(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := tenantContext("a")
+
+	artist := createArtist(t, ctx, svc, "Artist")
+	for _, title := range []string{"Delta", "Alpha", "Echo", "Charlie", "Bravo"} {
+		createSong(t, ctx, svc, artist.Id, title, setmakerpb.Key_KEY_C, setmakerpb.Tonality_TONALITY_MAJOR)
+	}
+
+	var titles []string
+	cursor := ""
+	for {
+		res, err := svc.ListSongs(ctx, &setmakerpb.ListSongsRequest{
+			Limit:   2,
+			Cursor:  cursor,
+			OrderBy: setmakerpb.SongOrder_SONG_ORDER_TITLE,
+		})
+		if err != nil {
+			t.Fatalf("ListSongs returned error: %s", err)
+		}
+		for _, song := range res.Items {
+			titles = append(titles, song.Title)
+		}
+
+		if res.Cursor == "" {
+			break
+		}
+		cursor = res.Cursor
+	}
+
+	want := []string{"Alpha", "Bravo", "Charlie", "Delta", "Echo"}
+	if fmt.Sprint(titles) != fmt.Sprint(want) {
+		t.Errorf("titles = %v, want %v", titles, want)
+	}
+}
+
+
+func TestListSongsFiltersByCreationTime(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := tenantContext("a")
+
+	artist := createArtist(t, ctx, svc, "Artist")
+	first := createSong(t, ctx, svc, artist.Id, "First", setmakerpb.Key_KEY_C, setmakerpb.Tonality_TONALITY_MAJOR)
+	second := createSong(t, ctx, svc, artist.Id, "Second", setmakerpb.Key_KEY_C, setmakerpb.Tonality_TONALITY_MAJOR)
+
+	res, err := svc.ListSongs(ctx, &setmakerpb.ListSongsRequest{
+		CreatedAfter: timestamppb.New(mustParseTimestamp(t, second.Metadata.CreatedAt)),
+	})
+	if err != nil {
+		t.Fatalf("ListSongs returned error: %s", err)
+	}
+	if res.Count != 1 || res.Items[0].Id != second.Id {
+		t.Errorf("CreatedAfter returned %v, want only %s", res.Items, second.Id)
+	}
+
+	res, err = svc.ListSongs(ctx, &setmakerpb.ListSongsRequest{
+		CreatedBefore: timestamppb.New(mustParseTimestamp(t, second.Metadata.CreatedAt)),
+	})
+	if err != nil {
+		t.Fatalf("ListSongs returned error: %s", err)
+	}
+	if res.Count != 1 || res.Items[0].Id != first.Id {
+		t.Errorf("CreatedBefore returned %v, want only %s", res.Items, first.Id)
+	}
+}
+
+
+func TestListCursorsAreBoundToTheirQuery(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := tenantContext("a")
+
+	artist := createArtist(t, ctx, svc, "Artist")
+	for _, title := range []string{"One", "Two", "Three"} {
+		createSong(t, ctx, svc, artist.Id, title, setmakerpb.Key_KEY_C, setmakerpb.Tonality_TONALITY_MAJOR)
+	}
+
+	res, err := svc.ListSongs(ctx, &setmakerpb.ListSongsRequest{Limit: 1, OrderBy: setmakerpb.SongOrder_SONG_ORDER_TITLE})
+	if err != nil {
+		t.Fatalf("ListSongs returned error: %s", err)
+	}
+	if res.Cursor == "" {
+		t.Fatal("expected a cursor for the next page")
+	}
+
+	_, err = svc.ListSongs(ctx, &setmakerpb.ListSongsRequest{Limit: 1, Cursor: res.Cursor})
+	assertCode(t, err, codes.InvalidArgument)
+
+	_, err = svc.ListArtists(ctx, 1, res.Cursor)
+	assertCode(t, err, codes.InvalidArgument)
+
+	_, err = svc.ListSongs(ctx, &setmakerpb.ListSongsRequest{Limit: 1, Cursor: "not a cursor"})
+	assertCode(t, err, codes.InvalidArgument)
+}
+
+
+func TestBatchGetSongsReportsMissingIds(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := tenantContext("a")
+
+	artist := createArtist(t, ctx, svc, "Artist")
+	song := createSong(t, ctx, svc, artist.Id, "Title", setmakerpb.Key_KEY_C, setmakerpb.Tonality_TONALITY_MAJOR)
+	missing := "3f1d8a52-1a4b-4c6e-9a57-0b6f2d7c9e10"
+
+	res, err := svc.BatchGetSongs(ctx, []string{missing, song.Id})
+	if err != nil {
+		t.Fatalf("BatchGetSongs returned error: %s", err)
+	}
+	if len(res.Songs) != 1 || res.Songs[0].Id != song.Id {
+		t.Errorf("songs = %v, want only %s", res.Songs, song.Id)
+	}
+	if len(res.MissingIds) != 1 || res.MissingIds[0] != missing {
+		t.Errorf("missing = %v, want [%s]", res.MissingIds, missing)
+	}
+
+	ids := make([]string, service.MaxBatchGetIds+1)
+	for i := range ids {
+		ids[i] = missing
+	}
+	_, err = svc.BatchGetSongs(ctx, ids)
+	assertCode(t, err, codes.InvalidArgument)
+}
@@ -0,0 +1,119 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	"google.golang.org/grpc/codes"
+)
+
+func TestTenantsCantReachEachOthersData(t *testing.T) {
+	svc, _ := newTestService(t)
+	a, b := tenantContext("a"), tenantContext("b")
+
+	artist := createArtist(t, a, svc, "Artist")
+	song := createSong(t, a, svc, artist.Id, "Title", setmakerpb.Key_KEY_C, setmakerpb.Tonality_TONALITY_MAJOR)
+
+	_, err := svc.GetArtist(b, mustParse(t, artist.Id))
+	assertCode(t, err, codes.NotFound)
+
+	_, err = svc.GetSong(b, mustParse(t, song.Id))
+	assertCode(t, err, codes.NotFound)
+
+	_, err = svc.UpdateArtist(b, &setmakerpb.Artist{Id: artist.Id, Name: "Taken"}, mask("name"), 0)
+	assertCode(t, err, codes.NotFound)
+
+	_, err = svc.DeleteArtist(b, mustParse(t, artist.Id), cascade)
+	assertCode(t, err, codes.NotFound)
+
+	_, err = svc.CreateSong(b, &setmakerpb.Song{Title: "Title", ArtistId: artist.Id, Key: setmakerpb.Key_KEY_C, Tonality: setmakerpb.Tonality_TONALITY_MAJOR})
+	assertCode(t, err, codes.NotFound)
+
+	artists, err := svc.ListArtists(b, 10, "")
+	if err != nil {
+		t.Fatalf("ListArtists returned error: %s", err)
+	}
+	if artists.Count != 0 || artists.TotalEstimate != 0 {
+		t.Errorf("tenant b sees %d artists of an estimated %d", artists.Count, artists.TotalEstimate)
+	}
+
+	songs, err := svc.ListSongs(b, &setmakerpb.ListSongsRequest{})
+	if err != nil {
+		t.Fatalf("ListSongs returned error: %s", err)
+	}
+	if songs.Count != 0 {
+		t.Errorf("tenant b sees %d songs", songs.Count)
+	}
+
+	if _, err = svc.GetArtist(a, mustParse(t, artist.Id)); err != nil {
+		t.Errorf("tenant a lost its artist: %s", err)
+	}
+}
+
+
+func TestArtistNamesAreUniquePerTenant(t *testing.T) {
+	svc, _ := newTestService(t)
+	a, b := tenantContext("a"), tenantContext("b")
+
+	createArtist(t, a, svc, "Artist")
+	createArtist(t, b, svc, "Artist")
+
+	_, err := svc.CreateArtist(b, &setmakerpb.Artist{Name: "Artist"})
+	assertCode(t, err, codes.AlreadyExists)
+}
+
+
+func TestCursorsAreBoundToTheirTenant(t *testing.T) {
+	svc, _ := newTestService(t)
+	a, b := tenantContext("a"), tenantContext("b")
+
+	createArtist(t, a, svc, "One")
+	createArtist(t, a, svc, "Two")
+
+	res, err := svc.ListArtists(a, 1, "")
+	if err != nil {
+		t.Fatalf("ListArtists returned error: %s", err)
+	}
+	if res.Cursor == "" {
+		t.Fatal("expected a cursor for the next page")
+	}
+
+	_, err = svc.ListArtists(b, 1, res.Cursor)
+	assertCode(t, err, codes.InvalidArgument)
+}
+
+
+func TestSearchIsScopedToTheTenant(t *testing.T) {
+	svc, _ := newTestService(t)
+	a, b := tenantContext("a"), tenantContext("b")
+
+	createArtist(t, a, svc, "Radiohead")
+
+	res, err := svc.Search(a, "radio", 10)
+	if err != nil {
+		t.Fatalf("Search returned error: %s", err)
+	}
+	if len(res.Artists) != 1 {
+		t.Errorf("tenant a found %d artists, want 1", len(res.Artists))
+	}
+
+	res, err = svc.Search(b, "radio", 10)
+	if err != nil {
+		t.Fatalf("Search returned error: %s", err)
+	}
+	if len(res.Artists) != 0 {
+		t.Errorf("tenant b found %d artists, want 0", len(res.Artists))
+	}
+}
+
+
+func TestCallsRequireATenant(t *testing.T) {
+	svc, _ := newTestService(t)
+
+	_, err := svc.CreateArtist(context.Background(), &setmakerpb.Artist{Name: "Artist"})
+	assertCode(t, err, codes.InvalidArgument)
+
+	_, err = svc.ListArtists(context.Background(), 10, "")
+	assertCode(t, err, codes.InvalidArgument)
+}
@@ -0,0 +1,117 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pete-robinson/set-maker-grpc/internal/auth"
+	"github.com/pete-robinson/set-maker-grpc/internal/repository/memory"
+	"github.com/pete-robinson/set-maker-grpc/internal/service"
+	"github.com/pete-robinson/set-maker-grpc/internal/tenant"
+	"github.com/pete-robinson/set-maker-grpc/internal/utils"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestMain(m *testing.M) {
+	logger.SetOutput(io.Discard)
+	os.Exit(m.Run())
+}
+
+
+// a service over a fresh memory repository, with a clock that ticks a second per call
+func newTestService(t *testing.T) (*service.Service, *memory.MemoryRepository) {
+	t.Helper()
+
+	repo := memory.NewMemoryRepository(utils.NewCursorCodec([]byte("test secret")), time.Hour)
+	svc := service.NewService(repo)
+
+	now := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	svc.SetClock(func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	})
+
+	return svc, repo
+}
+
+
+// a context acting for a tenant as an authenticated caller
+func tenantContext(tenantId string) context.Context {
+	ctx := auth.NewContext(context.Background(), &auth.Identity{Subject: "alice"})
+	return tenant.NewContext(ctx, tenantId)
+}
+
+
+func createArtist(t *testing.T, ctx context.Context, svc *service.Service, name string) *setmakerpb.Artist {
+	t.Helper()
+
+	artist, err := svc.CreateArtist(ctx, &setmakerpb.Artist{Name: name})
+	if err != nil {
+		t.Fatalf("CreateArtist(%q) returned error: %s", name, err)
+	}
+
+	return artist
+}
+
+
+func createSong(t *testing.T, ctx context.Context, svc *service.Service, artistId string, title string, key setmakerpb.Key, tonality setmakerpb.Tonality) *setmakerpb.Song {
+	t.Helper()
+
+	song, err := svc.CreateSong(ctx, &setmakerpb.Song{
+		Title:    title,
+		ArtistId: artistId,
+		Key:      key,
+		Tonality: tonality,
+	})
+	if err != nil {
+		t.Fatalf("CreateSong(%q) returned error: %s", title, err)
+	}
+
+	return song
+}
+
+
+func assertCode(t *testing.T, err error, want codes.Code) {
+	t.Helper()
+
+	if got := status.Code(err); got != want {
+		t.Fatalf("got code %s (%v), want %s", got, err, want)
+	}
+}
+
+
+func mustParse(t *testing.T, id string) uuid.UUID {
+	t.Helper()
+
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		t.Fatalf("could not parse %q: %s", id, err)
+	}
+
+	return parsed
+}
+
+
+func mask(paths ...string) *fieldmaskpb.FieldMask {
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+
+func mustParseTimestamp(t *testing.T, value string) time.Time {
+	t.Helper()
+
+	parsed, err := utils.ParseTimestamp(value)
+	if err != nil {
+		t.Fatalf("could not parse timestamp %q: %s", value, err)
+	}
+
+	return parsed
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	repository "github.com/pete-robinson/set-maker-grpc/internal/repository/ddb"
+	"github.com/pete-robinson/set-maker-grpc/internal/utils"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+
+// Paginated list of deleted artists, soonest to be purged first
+func (s *Service) ListDeletedArtists(ctx context.Context, limit int32, cursor string) (*repository.ArtistList, error) {
+	res, err := s.repository.ListDeletedArtists(ctx, pageSize(limit), cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.WithFields(logger.Fields{
+		"result count": res.Count,
+		"cursor": res.Cursor,
+	}).Info("Deleted artists found")
+
+	return res, nil
+}
+
+
+// Paginated list of deleted songs, soonest to be purged first
+func (s *Service) ListDeletedSongs(ctx context.Context, limit int32, cursor string) (*repository.SongList, error) {
+	res, err := s.repository.ListSongs(ctx, pageSize(limit), cursor, repository.SongQuery{Deleted: true})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.WithFields(logger.Fields{
+		"result count": res.Count,
+		"cursor": res.Cursor,
+	}).Info("Deleted songs found")
+
+	return res, nil
+}
+
+
+// Restore a deleted artist along with the songs that were deleted with it
+// Songs deleted on their own before the artist stay deleted. Fails with AlreadyExists if another
+// artist has taken the name in the meantime. The songs are restored before the artist, so a restore
+// that fails partway leaves the artist in the trash and restoring it again finishes the job.
+// Returns the number of songs restored
+func (s *Service) RestoreArtist(ctx context.Context, id uuid.UUID) (*setmakerpb.Artist, int32, error) {
+	artist, err := s.repository.GetDeletedArtist(ctx, id)
+	if err != nil {
+		logger.WithField("id", id).Errorf("Could not fetch deleted artist: %s", err)
+		return nil, 0, err
+	}
+
+	// don't bring the songs back for an artist that can't follow them
+	holder, err := s.repository.GetArtistByName(ctx, artist.Name)
+	if err == nil && holder.Id != artist.Id {
+		return nil, 0, status.Error(codes.AlreadyExists, "An artist with this name already exists")
+	}
+	if err != nil && status.Code(err) != codes.NotFound {
+		logger.WithField("id", id).Errorf("Could not check artist name is free: %s", err)
+		return nil, 0, err
+	}
+
+	deletedAt := artist.Metadata.DeletedAt
+	restored, err := s.restoreSongsByArtist(ctx, id, deletedAt)
+	if err != nil {
+		return nil, restored, status.Errorf(status.Code(err), "Only %d of the artist's songs were restored and the artist is still deleted. Restore the artist again to finish: %s", restored, status.Convert(err).Message())
+	}
+
+	utils.ClearDeleted(ctx, artist.Metadata, s.clock())
+	if err = s.repository.PutArtist(ctx, artist, newArtistRestoredEvent(artist)); err != nil {
+		logger.WithField("id", id).Errorf("Could not restore artist: %s", err)
+		return nil, restored, status.Errorf(status.Code(err), "Restored %d of the artist's songs but not the artist. Restore the artist again to finish: %s", restored, status.Convert(err).Message())
+	}
+	s.indexArtist(ctx, artist)
+
+	return artist, restored, nil
+}
+
+
+// walk the artist's deleted songs a page at a time, restoring those deleted along with the artist
+func (s *Service) restoreSongsByArtist(ctx context.Context, id uuid.UUID, deletedAt string) (int32, error) {
+	var restored int32
+	cursor := ""
+
+	for {
+		songs, err := s.repository.ListSongs(ctx, CascadeBatchSize, cursor, repository.SongQuery{ArtistId: id.String(), Deleted: true})
+		if err != nil {
+			logger.WithField("id", id).Errorf("Could not list deleted songs for artist: %s", err)
+			return restored, err
+		}
+
+		var targets []*setmakerpb.Song
+		var events []*setmakerpb.Event
+		for _, song := range songs.Items {
+			if song.Metadata.DeletedAt != deletedAt {
+				continue
+			}
+			utils.ClearDeleted(ctx, song.Metadata, s.clock())
+
+			targets = append(targets, song)
+			events = append(events, newSongRestoredEvent(song))
+		}
+
+		if len(targets) > 0 {
+			if err = s.repository.UpdateSongs(ctx, targets, events); err != nil {
+				return restored, err
+			}
+			restored += int32(len(targets))
+
+			for _, song := range targets {
+				s.indexSong(ctx, song)
+			}
+		}
+
+		if songs.Cursor == "" {
+			break
+		}
+		cursor = songs.Cursor
+	}
+
+	logger.WithFields(logger.Fields{
+		"artist": id,
+		"restored": restored,
+	}).Info("Cascade restored songs for artist")
+
+	return restored, nil
+}
+
+
+// Restore a deleted song
+// The song's artist must not be deleted, so restore the artist first if it is
+func (s *Service) RestoreSong(ctx context.Context, id uuid.UUID) (*setmakerpb.Song, error) {
+	song, err := s.repository.GetDeletedSong(ctx, id)
+	if err != nil {
+		logger.WithField("id", id).Errorf("Could not fetch deleted song: %s", err)
+		return nil, err
+	}
+
+	artistId, err := uuid.Parse(song.ArtistId)
+	if err != nil {
+		logger.WithField("uuid", song.ArtistId).Errorf("Could not parse artist UUID: %s", err)
+		return nil, status.Error(codes.Internal, "Invalid artist Id for song")
+	}
+
+	if _, err = s.repository.GetArtist(ctx, artistId); status.Code(err) == codes.NotFound {
+		return nil, status.Error(codes.FailedPrecondition, "The song's artist is deleted. Restore the artist first")
+	} else if err != nil {
+		return nil, err
+	}
+
+	utils.ClearDeleted(ctx, song.Metadata, s.clock())
+
+	if err = s.repository.PutSong(ctx, song, newSongRestoredEvent(song)); err != nil {
+		logger.WithField("id", id).Errorf("Could not restore song: %s", err)
+		return nil, err
+	}
+	s.indexSong(ctx, song)
+
+	return song, nil
+}
@@ -26,26 +26,11 @@ func NewSnsClient(client *sns.Client, topic SnsTopic) *SnsClient {
 }
 
 
-func (s *SnsClient) RaiseArtistCreatedEvent(ctx context.Context, artist *setmakerpb.Artist) error {
-	// build the message body
-	event := &setmakerpb.Event{
-		EventType: setmakerpb.Event_EVENT_ARTIST_CREATED,
-		MessageBody: &setmakerpb.Event_ArtistCreated{
-			ArtistCreated: &setmakerpb.MessageBody_ArtistCreated{
-				Id:   artist.Id,
-				Name: artist.Name,
-			},
-		},
-	}
-
+// Publish an event to the topic, returning the SNS message ID
+func (s *SnsClient) Publish(ctx context.Context, event *setmakerpb.Event) (*string, error) {
 	logger.WithField("MessageBody", event).Infof("Raising event: %s", setmakerpb.Event_EventType_name[int32(event.EventType)])
 
-	// raise the event
-	if _, err := s.raise(ctx, event); err != nil {
-		return err
-	}
-
-	return nil
+	return s.raise(ctx, event)
 }
 
 
@@ -0,0 +1,174 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/pete-robinson/set-maker-grpc/internal/service/harmony"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	MaxArtistNameLength = 200
+	MaxImageLength      = 2048
+	MaxSongTitleLength  = 200
+)
+
+// Collects field violations for a request
+type Violations struct {
+	violations []*errdetails.BadRequest_FieldViolation
+}
+
+
+func (v *Violations) Add(field string, description string) {
+	v.violations = append(v.violations, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: description,
+	})
+}
+
+
+// InvalidArgument status carrying a BadRequest detail with every violation, or nil if there were none
+func (v *Violations) Err() error {
+	if len(v.violations) == 0 {
+		return nil
+	}
+
+	logger.WithField("violations", v.violations).Warn("Request failed validation")
+
+	st := status.New(codes.InvalidArgument, "Request failed validation")
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: v.violations})
+	if err != nil {
+		// details couldn't be attached, so fall back to listing the fields in the message
+		fields := make([]string, 0, len(v.violations))
+		for _, violation := range v.violations {
+			fields = append(fields, violation.Field)
+		}
+		return status.Errorf(codes.InvalidArgument, "Request failed validation: %s", strings.Join(fields, ", "))
+	}
+
+	return detailed.Err()
+}
+
+
+// Validate the given fields of an artist
+// paths are the proto field names being written, as used in update masks
+func ValidateArtist(artist *setmakerpb.Artist, paths []string) error {
+	v := &Violations{}
+
+	for _, path := range paths {
+		switch path {
+		case "name":
+			requiredString(v, path, artist.Name, MaxArtistNameLength)
+		case "image":
+			maxLength(v, path, artist.Image, MaxImageLength)
+		}
+	}
+
+	return v.Err()
+}
+
+
+// Validate the given fields of a song
+// paths are the proto field names being written, as used in update masks
+func ValidateSong(song *setmakerpb.Song, paths []string) error {
+	v := &Violations{}
+
+	for _, path := range paths {
+		switch path {
+		case "title":
+			requiredString(v, path, song.Title, MaxSongTitleLength)
+		case "artistId":
+			if _, err := uuid.Parse(song.ArtistId); err != nil {
+				v.Add(path, "must be a valid artist id")
+			}
+		case "key":
+			if harmony.KeyName(song.Key) == "" {
+				v.Add(path, "must be one of the twelve keys")
+			}
+		case "tonality":
+			if song.Tonality != setmakerpb.Tonality_TONALITY_MAJOR && song.Tonality != setmakerpb.Tonality_TONALITY_MINOR {
+				v.Add(path, "must be major or minor")
+			}
+		}
+	}
+
+	return v.Err()
+}
+
+
+// Validate a catalog import row
+func ValidateImportRow(row *setmakerpb.ImportCatalogRow) error {
+	v := &Violations{}
+
+	requiredString(v, "artistName", row.ArtistName, MaxArtistNameLength)
+	requiredString(v, "title", row.Title, MaxSongTitleLength)
+	if harmony.KeyName(row.Key) == "" {
+		v.Add("key", "must be one of the twelve keys")
+	}
+	if row.Tonality != setmakerpb.Tonality_TONALITY_MAJOR && row.Tonality != setmakerpb.Tonality_TONALITY_MINOR {
+		v.Add("tonality", "must be major or minor")
+	}
+
+	return v.Err()
+}
+
+
+func requiredString(v *Violations, field string, value string, max int) {
+	if strings.TrimSpace(value) == "" {
+		v.Add(field, "is required")
+		return
+	}
+
+	maxLength(v, field, value, max)
+}
+
+
+func maxLength(v *Violations, field string, value string, max int) {
+	if utf8.RuneCountInString(value) > max {
+		v.Add(field, fmt.Sprintf("must be at most %d characters", max))
+	}
+}
+
+
+// Validate the filters and ordering of a song listing
+func ValidateListSongs(req *setmakerpb.ListSongsRequest) error {
+	v := &Violations{}
+
+	if req.Key != setmakerpb.Key_KEY_UNKNOWN && harmony.KeyName(req.Key) == "" {
+		v.Add("key", "must be one of the twelve keys")
+	}
+	if req.Tonality != setmakerpb.Tonality_TONALITY_UNKNOWN && req.Tonality != setmakerpb.Tonality_TONALITY_MAJOR && req.Tonality != setmakerpb.Tonality_TONALITY_MINOR {
+		v.Add("tonality", "must be major or minor")
+	}
+	if req.ArtistId != "" {
+		if _, err := uuid.Parse(req.ArtistId); err != nil {
+			v.Add("artistId", "must be a valid artist id")
+		}
+	}
+
+	if req.CreatedAfter != nil && req.CreatedAfter.CheckValid() != nil {
+		v.Add("createdAfter", "must be a valid timestamp")
+	}
+	if req.CreatedBefore != nil && req.CreatedBefore.CheckValid() != nil {
+		v.Add("createdBefore", "must be a valid timestamp")
+	}
+	if req.CreatedAfter != nil && req.CreatedBefore != nil && !req.CreatedAfter.AsTime().Before(req.CreatedBefore.AsTime()) {
+		v.Add("createdBefore", "must be after createdAfter")
+	}
+
+	if _, ok := setmakerpb.SongOrder_name[int32(req.OrderBy)]; !ok {
+		v.Add("orderBy", "must be a known ordering")
+	}
+	if req.Descending && req.OrderBy == setmakerpb.SongOrder_SONG_ORDER_UNORDERED {
+		v.Add("descending", "requires orderBy")
+	}
+
+	return v.Err()
+}
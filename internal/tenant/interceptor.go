@@ -0,0 +1,103 @@
+package tenant
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pete-robinson/set-maker-grpc/internal/auth"
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Reads the tenant from request metadata and puts it into the request's context
+// Runs after authentication. The caller's token must list the tenant in its tenants claim,
+// so a caller can only act for tenants it has been granted, and its roles in that tenant
+// must allow the method
+type Resolver struct {
+	authorizer *auth.Authorizer
+	allowList  []string
+}
+
+
+func NewResolver(authorizer *auth.Authorizer, allowList []string) *Resolver {
+	return &Resolver{
+		authorizer: authorizer,
+		allowList:  allowList,
+	}
+}
+
+
+func (r *Resolver) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := r.resolve(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+
+func (r *Resolver) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := r.resolve(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &tenantStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+
+func (r *Resolver) resolve(ctx context.Context, method string) (context.Context, error) {
+	if auth.Allowed(r.allowList, method) {
+		return ctx, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(MetadataKey)
+	if len(values) != 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "Exactly one %s is required", MetadataKey)
+	}
+
+	id := strings.TrimSpace(values[0])
+	if err := Validate(id); err != nil {
+		return nil, err
+	}
+
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Missing bearer token")
+	}
+	if !identity.HasTenant(id) {
+		logger.WithFields(logger.Fields{
+			"method":  method,
+			"subject": identity.Subject,
+			"tenant":  id,
+		}).Warn("Tenant: Caller is not a member of tenant")
+		return nil, status.Error(codes.PermissionDenied, "Not permitted to act for this tenant")
+	}
+
+	if err := r.authorizer.Authorize(identity, id, method); err != nil {
+		return nil, err
+	}
+
+	return NewContext(ctx, id), nil
+}
+
+
+// a server stream acting for a tenant
+type tenantStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+
+func (s *tenantStream) Context() context.Context {
+	return s.ctx
+}
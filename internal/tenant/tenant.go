@@ -0,0 +1,50 @@
+package tenant
+
+import (
+	"context"
+	"regexp"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// request metadata naming the tenant a request acts for
+const MetadataKey = "x-tenant-id"
+
+// tenant ids are used in storage keys, so are limited to characters that can't be mistaken for separators
+var validId = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+type tenantKey struct{}
+
+
+// copy of ctx acting for a tenant
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, id)
+}
+
+
+// the tenant a request acts for, if it has one
+func FromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(tenantKey{}).(string)
+	return id, ok && id != ""
+}
+
+
+// the tenant a request acts for, or an error for storage calls made without one
+func Require(ctx context.Context) (string, error) {
+	id, ok := FromContext(ctx)
+	if !ok {
+		return "", status.Error(codes.InvalidArgument, "A tenant is required")
+	}
+
+	return id, nil
+}
+
+
+func Validate(id string) error {
+	if !validId.MatchString(id) {
+		return status.Error(codes.InvalidArgument, "Tenant Id must be 1 to 64 letters, digits, dashes or underscores")
+	}
+
+	return nil
+}
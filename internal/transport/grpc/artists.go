@@ -32,6 +32,18 @@ func (s *Server) GetArtist(ctx context.Context, id *wrapperspb.StringValue) (*se
 }
 
 
+func (s *Server) GetArtistByName(ctx context.Context, name *wrapperspb.StringValue) (*setmakerpb.Artist, error) {
+	logger.WithField("name", name.GetValue()).Info("GRPC: Fetching artist by name")
+
+	artist, err := s.service.GetArtistByName(ctx, name.GetValue())
+	if err != nil {
+		return nil, err
+	}
+
+	return artist, nil
+}
+
+
 func (s *Server) CreateArtist(ctx context.Context, req *setmakerpb.CreateArtistRequest) (*setmakerpb.Artist, error) {
 	logger.WithField("request", req).Info("GRPC: Creating artist")
 
@@ -65,7 +77,7 @@ func (s *Server) UpdateArtist(ctx context.Context, req *setmakerpb.UpdateArtistR
 	}
 
 	// attempt update
-	resp, err := s.service.UpdateArtist(ctx, artist)
+	resp, err := s.service.UpdateArtist(ctx, artist, req.UpdateMask, req.ExpectedVersion)
 	if err != nil {
 		return nil, err
 	}
@@ -74,13 +86,13 @@ func (s *Server) UpdateArtist(ctx context.Context, req *setmakerpb.UpdateArtistR
 }
 
 
-func (s *Server) DeleteArtist(ctx context.Context, id *wrapperspb.StringValue) (*setmakerpb.DeleteArtistResponse, error) {
-	logger.WithField("id", id.GetValue()).Info("GRPC: Deleting artist")
+func (s *Server) DeleteArtist(ctx context.Context, req *setmakerpb.DeleteArtistRequest) (*setmakerpb.DeleteArtistResponse, error) {
+	logger.WithField("request", req).Info("GRPC: Deleting artist")
 
 	// parse UUID
-	uuid, err := uuid.Parse(id.GetValue())
+	uuid, err := uuid.Parse(req.GetId())
 	if err != nil {
-		logger.WithField("id", id.GetValue()).Errorf("ID will not parse %s", err)
+		logger.WithField("id", req.GetId()).Errorf("ID will not parse %s", err)
 		return nil, status.Error(codes.InvalidArgument, "Invalid data for Id")
 	}
 
@@ -92,12 +104,17 @@ func (s *Server) DeleteArtist(ctx context.Context, id *wrapperspb.StringValue) (
 		Deleted: false,
 	}
 
-	if err = s.service.DeleteArtist(ctx, uuid); err != nil {
+	deletedSongs, err := s.service.DeleteArtist(ctx, uuid, req.GetPolicy())
+	if err != nil {
 		return nil, err
 	}
 
-	logger.WithField("id", uuid.String()).Info("Artist deleted successfully")
+	logger.WithFields(logger.Fields{
+		"id": uuid.String(),
+		"deletedSongs": deletedSongs,
+	}).Info("Artist deleted successfully")
 	resp.Deleted = true
+	resp.DeletedSongs = deletedSongs
 
 	return resp, nil
 }
@@ -118,7 +135,23 @@ func (s *Server) ListArtists(ctx context.Context, req *setmakerpb.ListArtistsReq
 	r := &setmakerpb.ListArtistsResponse{
 		Results: resp.Items,
 		SearchAfter: resp.Cursor,
+		TotalEstimate: resp.TotalEstimate,
 	}
 
 	return r, nil
 }
+
+
+func (s *Server) BatchGetArtists(ctx context.Context, req *setmakerpb.BatchGetRequest) (*setmakerpb.BatchGetArtistsResponse, error) {
+	logger.WithField("ids", req.Ids).Info("GRPC: Fetching artists")
+
+	res, err := s.service.BatchGetArtists(ctx, req.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	return &setmakerpb.BatchGetArtistsResponse{
+		Artists: res.Artists,
+		MissingIds: res.MissingIds,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"io"
+
+	"github.com/pete-robinson/set-maker-grpc/internal/service"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+
+func (s *Server) ImportCatalog(stream setmakerpb.SetMakerService_ImportCatalogServer) error {
+	logger.Info("GRPC: Importing catalog")
+
+	// collect the rows from the client
+	var rows []*setmakerpb.ImportCatalogRow
+	for {
+		row, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			logger.Errorf("Error receiving import row: %s", err)
+			return err
+		}
+
+		if len(rows) == service.MaxImportRows {
+			return status.Errorf(codes.InvalidArgument, "An import can contain at most %d rows", service.MaxImportRows)
+		}
+		rows = append(rows, row)
+	}
+
+	report, err := s.service.ImportCatalog(stream.Context(), rows)
+	if err != nil {
+		return err
+	}
+
+	resp := &setmakerpb.ImportCatalogResponse{
+		Results:        make([]*setmakerpb.ImportRowResult, 0, len(report.Results)),
+		Imported:       report.Imported,
+		Failed:         report.Failed,
+		ArtistsCreated: report.ArtistsCreated,
+	}
+	for _, result := range report.Results {
+		resp.Results = append(resp.Results, &setmakerpb.ImportRowResult{
+			Row:      result.Row,
+			Imported: result.Error == "",
+			SongId:   result.SongId,
+			ArtistId: result.ArtistId,
+			Error:    result.Error,
+		})
+	}
+
+	return stream.SendAndClose(resp)
+}
+
+
+func (s *Server) ExportCatalog(req *setmakerpb.ExportCatalogRequest, stream setmakerpb.SetMakerService_ExportCatalogServer) error {
+	logger.Info("GRPC: Exporting catalog")
+
+	return s.service.ExportCatalog(stream.Context(), stream.Send)
+}
@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pete-robinson/set-maker-grpc/internal/service/harmony"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var keyRelations = map[harmony.Relation]setmakerpb.KeyRelation{
+	harmony.RelationSameKey:   setmakerpb.KeyRelation_KEY_RELATION_SAME_KEY,
+	harmony.RelationRelative:  setmakerpb.KeyRelation_KEY_RELATION_RELATIVE,
+	harmony.RelationFifthUp:   setmakerpb.KeyRelation_KEY_RELATION_FIFTH_UP,
+	harmony.RelationFifthDown: setmakerpb.KeyRelation_KEY_RELATION_FIFTH_DOWN,
+}
+
+
+func (s *Server) FindCompatibleSongs(ctx context.Context, req *setmakerpb.FindCompatibleSongsRequest) (*setmakerpb.FindCompatibleSongsResponse, error) {
+	logger.WithField("req", req).Info("GRPC: Finding compatible songs")
+
+	// parse UUID
+	uuid, err := uuid.Parse(req.SongId)
+	if err != nil {
+		logger.WithField("uuid", req.SongId).Errorf("Could not parse UUID: %s", err)
+		return nil, status.Error(codes.InvalidArgument, "Invalid song Id")
+	}
+
+	res, err := s.service.FindCompatibleSongs(ctx, uuid, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &setmakerpb.FindCompatibleSongsResponse{
+		Song:    res.Song,
+		Camelot: res.Camelot.String(),
+	}
+
+	for _, match := range res.Results {
+		r.Results = append(r.Results, &setmakerpb.CompatibleSong{
+			Song:     match.Song,
+			Camelot:  match.Camelot.String(),
+			Relation: keyRelations[match.Relation],
+		})
+	}
+
+	return r, nil
+}
+
+
+func (s *Server) OrderSongs(ctx context.Context, req *setmakerpb.OrderSongsRequest) (*setmakerpb.OrderSongsResponse, error) {
+	logger.WithField("req", req).Info("GRPC: Ordering songs")
+
+	res, err := s.service.OrderSongs(ctx, req.SongIds, req.OpenerId, req.CloserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &setmakerpb.OrderSongsResponse{
+		Results:       res.Songs,
+		TotalDistance: int32(res.TotalDistance),
+	}, nil
+}
@@ -0,0 +1,23 @@
+package grpc
+
+import (
+	"context"
+
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	logger "github.com/sirupsen/logrus"
+)
+
+
+func (s *Server) Search(ctx context.Context, req *setmakerpb.SearchRequest) (*setmakerpb.SearchResponse, error) {
+	logger.WithField("req", req).Info("GRPC: Searching")
+
+	res, err := s.service.Search(ctx, req.Query, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &setmakerpb.SearchResponse{
+		Artists: res.Artists,
+		Songs:   res.Songs,
+	}, nil
+}
@@ -0,0 +1,126 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+
+func (s *Server) GetSetlist(ctx context.Context, id *wrapperspb.StringValue) (*setmakerpb.Setlist, error) {
+	logger.WithField("id", id).Info("GRPC: Fetching setlist")
+
+	// parse UUID
+	uuid, err := uuid.Parse(id.Value)
+	if err != nil {
+		logger.WithField("uuid", id.Value).Errorf("Could not parse UUID: %s", err)
+		return nil, status.Error(codes.InvalidArgument, "Invalid setlist Id")
+	}
+
+	// fetch setlist from service
+	setlist, err := s.service.GetSetlist(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return setlist, nil
+}
+
+
+func (s *Server) CreateSetlist(ctx context.Context, req *setmakerpb.CreateSetlistRequest) (*setmakerpb.Setlist, error) {
+	logger.WithField("request", req).Info("GRPC: Creating setlist")
+
+	setlist := &setmakerpb.Setlist{
+		Name:  req.Name,
+		Venue: req.Venue,
+		Date:  req.Date,
+		Slots: req.Slots,
+	}
+
+	created, err := s.service.CreateSetlist(ctx, setlist)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+
+func (s *Server) UpdateSetlist(ctx context.Context, req *setmakerpb.UpdateSetlistRequest) (*setmakerpb.Setlist, error) {
+	logger.WithField("request", req).Info("GRPC: Updating setlist")
+
+	// validate the UUID
+	if _, err := uuid.Parse(req.Id); err != nil {
+		logger.WithField("uuid", req.Id).Errorf("Could not parse UUID: %s", err)
+		return nil, status.Error(codes.InvalidArgument, "Invalid setlist Id")
+	}
+
+	setlist := &setmakerpb.Setlist{
+		Id:    req.Id,
+		Name:  req.Name,
+		Venue: req.Venue,
+		Date:  req.Date,
+		Slots: req.Slots,
+	}
+
+	// attempt update
+	resp, err := s.service.UpdateSetlist(ctx, setlist)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+
+func (s *Server) DeleteSetlist(ctx context.Context, id *wrapperspb.StringValue) (*setmakerpb.DeleteSetlistResponse, error) {
+	logger.WithField("id", id.GetValue()).Info("GRPC: Deleting setlist")
+
+	// parse UUID
+	uuid, err := uuid.Parse(id.GetValue())
+	if err != nil {
+		logger.WithField("id", id.GetValue()).Errorf("ID will not parse %s", err)
+		return nil, status.Error(codes.InvalidArgument, "Invalid data for Id")
+	}
+
+	resp := &setmakerpb.DeleteSetlistResponse{
+		Id:      uuid.String(),
+		Deleted: false,
+	}
+
+	// run delete
+	if err = s.service.DeleteSetlist(ctx, uuid); err != nil {
+		return nil, err
+	}
+
+	logger.WithField("id", uuid.String()).Info("Setlist deleted successfully")
+	resp.Deleted = true
+
+	return resp, nil
+}
+
+
+func (s *Server) ListSetlists(ctx context.Context, req *setmakerpb.ListSetlistsRequest) (*setmakerpb.ListSetlistsResponse, error) {
+	logger.WithField("req", req).Info("GRPC: Listing setlists")
+
+	resp, err := s.service.ListSetlists(ctx, req.Limit, req.Cursor)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"limit": req.Limit,
+			"cursor": req.Cursor,
+		}).Errorf("Error listing setlists: %s", err)
+		return nil, err
+	}
+
+	r := &setmakerpb.ListSetlistsResponse{
+		Results: resp.Items,
+		SearchAfter: resp.Cursor,
+	}
+
+	return r, nil
+}
@@ -12,13 +12,13 @@ import (
 )
 
 
-func (s *Server) GetSong(ctx context.Context, id *wrapperspb.StringValue) (*setmakerpb.Song, error) {
-	logger.WithField("id", id).Info("GRPC: Fetching song")
+func (s *Server) GetSong(ctx context.Context, req *setmakerpb.GetSongRequest) (*setmakerpb.Song, error) {
+	logger.WithField("req", req).Info("GRPC: Fetching song")
 
 	// parse UUID
-	uuid, err := uuid.Parse(id.Value)
+	uuid, err := uuid.Parse(req.Id)
 	if err != nil {
-		logger.WithField("uuid", id.Value).Errorf("Could not parse UUID: %s", err)
+		logger.WithField("uuid", req.Id).Errorf("Could not parse UUID: %s", err)
 		return nil, status.Error(codes.InvalidArgument, "Invalid song Id")
 	}
 
@@ -28,6 +28,12 @@ func (s *Server) GetSong(ctx context.Context, id *wrapperspb.StringValue) (*setm
 		return nil, err
 	}
 
+	if req.ExpandArtist {
+		if err = s.service.ExpandArtists(ctx, song); err != nil {
+			return nil, err
+		}
+	}
+
 	return song, nil
 }
 
@@ -69,7 +75,7 @@ func (s *Server) UpdateSong(ctx context.Context, req *setmakerpb.UpdateSongReque
 	}
 
 	// attempt update
-	resp, err := s.service.UpdateSong(ctx, song)
+	resp, err := s.service.UpdateSong(ctx, song, req.UpdateMask, req.ExpectedVersion)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +116,7 @@ func (s *Server) DeleteSong(ctx context.Context, id *wrapperspb.StringValue) (*s
 func (s *Server) ListSongs(ctx context.Context, req *setmakerpb.ListSongsRequest) (*setmakerpb.ListSongsResponse, error) {
 	logger.WithField("req", req).Info("GRPC: Listing songs")
 
-	resp, err := s.service.ListSongs(ctx, req.Limit, req.Cursor)
+	resp, err := s.service.ListSongs(ctx, req)
 	if err != nil {
 		logger.WithFields(logger.Fields{
 			"limit": req.Limit,
@@ -119,9 +125,16 @@ func (s *Server) ListSongs(ctx context.Context, req *setmakerpb.ListSongsRequest
 		return nil, err
 	}
 
+	if req.ExpandArtist {
+		if err = s.service.ExpandArtists(ctx, resp.Items...); err != nil {
+			return nil, err
+		}
+	}
+
 	r := &setmakerpb.ListSongsResponse{
 		Results: resp.Items,
 		SearchAfter: resp.Cursor,
+		TotalEstimate: resp.TotalEstimate,
 	}
 
 	return r, nil
@@ -141,10 +154,32 @@ func (s *Server) ListSongsByArtist(ctx context.Context, req *setmakerpb.ListSong
 		return nil, err
 	}
 
+	if req.ExpandArtist {
+		if err = s.service.ExpandArtists(ctx, resp.Items...); err != nil {
+			return nil, err
+		}
+	}
+
 	r := &setmakerpb.ListSongsResponse{
 		Results: resp.Items,
 		SearchAfter: resp.Cursor,
+		TotalEstimate: resp.TotalEstimate,
 	}
 
 	return r, nil
 }
+
+
+func (s *Server) BatchGetSongs(ctx context.Context, req *setmakerpb.BatchGetRequest) (*setmakerpb.BatchGetSongsResponse, error) {
+	logger.WithField("ids", req.Ids).Info("GRPC: Fetching songs")
+
+	res, err := s.service.BatchGetSongs(ctx, req.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	return &setmakerpb.BatchGetSongsResponse{
+		Songs: res.Songs,
+		MissingIds: res.MissingIds,
+	}, nil
+}
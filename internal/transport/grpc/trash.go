@@ -0,0 +1,88 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+
+func (s *Server) RestoreArtist(ctx context.Context, id *wrapperspb.StringValue) (*setmakerpb.RestoreArtistResponse, error) {
+	logger.WithField("id", id.GetValue()).Info("GRPC: Restoring artist")
+
+	// parse UUID
+	uuid, err := uuid.Parse(id.GetValue())
+	if err != nil {
+		logger.WithField("id", id.GetValue()).Errorf("ID will not parse %s", err)
+		return nil, status.Error(codes.InvalidArgument, "Invalid data for Id")
+	}
+
+	artist, restoredSongs, err := s.service.RestoreArtist(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.WithFields(logger.Fields{
+		"id": uuid.String(),
+		"restoredSongs": restoredSongs,
+	}).Info("Artist restored successfully")
+
+	return &setmakerpb.RestoreArtistResponse{
+		Artist: artist,
+		RestoredSongs: restoredSongs,
+	}, nil
+}
+
+
+func (s *Server) RestoreSong(ctx context.Context, id *wrapperspb.StringValue) (*setmakerpb.Song, error) {
+	logger.WithField("id", id.GetValue()).Info("GRPC: Restoring song")
+
+	// parse UUID
+	uuid, err := uuid.Parse(id.GetValue())
+	if err != nil {
+		logger.WithField("id", id.GetValue()).Errorf("ID will not parse %s", err)
+		return nil, status.Error(codes.InvalidArgument, "Invalid data for Id")
+	}
+
+	song, err := s.service.RestoreSong(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.WithField("id", uuid.String()).Info("Song restored successfully")
+	return song, nil
+}
+
+
+func (s *Server) ListDeleted(ctx context.Context, req *setmakerpb.ListDeletedRequest) (*setmakerpb.ListDeletedResponse, error) {
+	logger.WithField("req", req).Info("GRPC: Listing deleted items")
+
+	switch req.Kind {
+	case setmakerpb.DeletedKind_DELETED_KIND_ARTISTS:
+		resp, err := s.service.ListDeletedArtists(ctx, req.Limit, req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		return &setmakerpb.ListDeletedResponse{
+			Artists: resp.Items,
+			SearchAfter: resp.Cursor,
+		}, nil
+
+	case setmakerpb.DeletedKind_DELETED_KIND_SONGS:
+		resp, err := s.service.ListDeletedSongs(ctx, req.Limit, req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		return &setmakerpb.ListDeletedResponse{
+			Songs: resp.Items,
+			SearchAfter: resp.Cursor,
+		}, nil
+	}
+
+	return nil, status.Error(codes.InvalidArgument, "Unknown kind of deleted item")
+}
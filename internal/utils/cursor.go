@@ -0,0 +1,168 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// Cursors look like <version>.<payload>.<signature>
+// payload is base64url JSON holding the last evaluated key and a fingerprint of the query it came from.
+// signature is base64url HMAC-SHA256 of <version>.<payload> with the server secret
+const CursorVersion = "1"
+
+var (
+	ErrInvalidCursor  = errors.New("invalid cursor")
+	ErrCursorMismatch = errors.New("cursor was issued for a different query")
+)
+
+// Describes the query a cursor was issued for
+// a cursor only decodes against the same tenant, table, index and params
+type QueryShape struct {
+	Tenant string
+	Table  string
+	Index  string
+	Params map[string]string
+}
+
+// Signs and verifies pagination cursors
+type CursorCodec struct {
+	secret []byte
+}
+
+type cursorPayload struct {
+	Query string                 `json:"q"`
+	Key   map[string]cursorValue `json:"k"`
+}
+
+// key attributes can only be strings, numbers or binary
+type cursorValue struct {
+	S *string `json:"s,omitempty"`
+	N *string `json:"n,omitempty"`
+	B []byte  `json:"b,omitempty"`
+}
+
+
+func NewCursorCodec(secret []byte) *CursorCodec {
+	return &CursorCodec{
+		secret: secret,
+	}
+}
+
+
+// Build a cursor from a DynamoDB LastEvaluatedKey
+// a nil key means there's nothing left to read, so gives an empty cursor
+func (c *CursorCodec) Encode(shape QueryShape, key map[string]types.AttributeValue) (string, error) {
+	if key == nil {
+		return "", nil
+	}
+
+	payload := cursorPayload{
+		Query: shape.fingerprint(),
+		Key:   make(map[string]cursorValue, len(key)),
+	}
+
+	for name, value := range key {
+		switch v := value.(type) {
+		case *types.AttributeValueMemberS:
+			payload.Key[name] = cursorValue{S: &v.Value}
+		case *types.AttributeValueMemberN:
+			payload.Key[name] = cursorValue{N: &v.Value}
+		case *types.AttributeValueMemberB:
+			payload.Key[name] = cursorValue{B: v.Value}
+		default:
+			return "", fmt.Errorf("unsupported key attribute type for %s", name)
+		}
+	}
+
+	jsn, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	body := CursorVersion + "." + base64.RawURLEncoding.EncodeToString(jsn)
+	return body + "." + base64.RawURLEncoding.EncodeToString(c.sign(body)), nil
+}
+
+
+// Verify a cursor and return the DynamoDB ExclusiveStartKey it holds
+// an empty cursor starts from the beginning, so gives a nil key
+func (c *CursorCodec) Decode(shape QueryShape, cursor string) (map[string]types.AttributeValue, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(cursor, ".")
+	if len(parts) != 3 || parts[0] != CursorVersion {
+		return nil, ErrInvalidCursor
+	}
+
+	// check the signature before trusting anything in the payload
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || !hmac.Equal(sig, c.sign(parts[0]+"."+parts[1])) {
+		return nil, ErrInvalidCursor
+	}
+
+	jsn, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var payload cursorPayload
+	if err = json.Unmarshal(jsn, &payload); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	if payload.Query != shape.fingerprint() {
+		return nil, ErrCursorMismatch
+	}
+
+	key := make(map[string]types.AttributeValue, len(payload.Key))
+	for name, value := range payload.Key {
+		switch {
+		case value.S != nil:
+			key[name] = &types.AttributeValueMemberS{Value: *value.S}
+		case value.N != nil:
+			key[name] = &types.AttributeValueMemberN{Value: *value.N}
+		case value.B != nil:
+			key[name] = &types.AttributeValueMemberB{Value: value.B}
+		default:
+			return nil, ErrInvalidCursor
+		}
+	}
+
+	return key, nil
+}
+
+
+func (c *CursorCodec) sign(body string) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write([]byte(body))
+	return mac.Sum(nil)
+}
+
+
+// stable digest of a query shape. params are sorted so map order doesn't matter
+func (q QueryShape) fingerprint() string {
+	names := make([]string, 0, len(q.Params))
+	for name := range q.Params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	h := sha256.New()
+	fmt.Fprintf(h, "%q %q %q", q.Tenant, q.Table, q.Index)
+	for _, name := range names {
+		fmt.Fprintf(h, " %q=%q", name, q.Params[name])
+	}
+
+	return hex.EncodeToString(h.Sum(nil)[:16])
+}
@@ -1,16 +1,108 @@
 package utils
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"time"
 
+	"github.com/pete-robinson/set-maker-grpc/internal/auth"
 	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
 )
 
+// Metadata timestamps are RFC3339 in UTC with nanoseconds always written out, so every timestamp
+// has the same width and string order is time order
+const TimestampLayout = "2006-01-02T15:04:05.000000000Z07:00"
+
+// layout of timestamps written before TimestampLayout, as produced by time.Time.String
+const legacyTimestampLayout = "2006-01-02 15:04:05.999999999 -0700 MST"
+
+
+// stamp a record being written at now with the authenticated caller in ctx
+// the created fields are only set on the first write
+func SetMetaData(ctx context.Context, meta *setmakerpb.Metadata, now time.Time) {
+	var subject string
+	if identity, ok := auth.FromContext(ctx); ok {
+		subject = identity.Subject
+	}
 
-func SetMetaData(meta *setmakerpb.Metadata) {
 	if meta.CreatedAt == "" {
-		meta.CreatedAt = time.Now().String()
+		meta.CreatedAt = FormatTimestamp(now)
+		meta.CreatedBy = subject
+	}
+
+	meta.UpdatedAt = FormatTimestamp(now)
+	meta.UpdatedBy = subject
+	meta.Version++
+}
+
+
+// format a time the way metadata timestamps are stored, so it can be compared with stored values
+func FormatTimestamp(t time.Time) string {
+	return t.UTC().Format(TimestampLayout)
+}
+
+
+// parse a stored timestamp in the current layout, any RFC3339 layout, or the legacy
+// time.Time.String layout, whose monotonic clock reading is ignored
+func ParseTimestamp(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t, nil
+	}
+
+	if i := strings.Index(value, " m="); i >= 0 {
+		value = value[:i]
+	}
+	t, err := time.Parse(legacyTimestampLayout, value)
+	if err != nil {
+		return time.Time{}, errors.New("unrecognised timestamp " + value)
+	}
+
+	return t, nil
+}
+
+
+// rewrite a stored timestamp in TimestampLayout. empty timestamps stay empty, and timestamps
+// already in the layout come back unchanged, so rewriting is safe to repeat
+func NormaliseTimestamp(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	t, err := ParseTimestamp(value)
+	if err != nil {
+		return "", err
+	}
+
+	return FormatTimestamp(t), nil
+}
+
+
+// mark a record as deleted at now. a delete is a write like any other, so the version is bumped too
+func SetDeleted(ctx context.Context, meta *setmakerpb.Metadata, now time.Time) {
+	meta.DeletedAt = FormatTimestamp(now)
+	SetMetaData(ctx, meta, now)
+}
+
+
+// clear the deleted marker of a record being restored at now
+func ClearDeleted(ctx context.Context, meta *setmakerpb.Metadata, now time.Time) {
+	meta.DeletedAt = ""
+	SetMetaData(ctx, meta, now)
+}
+
+
+// epoch time in seconds at which a deleted record's time in the trash runs out, counted from its
+// DeletedAt. records that aren't deleted have no expiry, given as 0
+func TrashExpiry(meta *setmakerpb.Metadata, retention time.Duration) (int64, error) {
+	if meta.GetDeletedAt() == "" {
+		return 0, nil
+	}
+
+	deletedAt, err := ParseTimestamp(meta.GetDeletedAt())
+	if err != nil {
+		return 0, err
 	}
 
-	meta.UpdatedAt = time.Now().String()
+	return deletedAt.Add(retention).Unix(), nil
 }
@@ -0,0 +1,160 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	setmakerpb "github.com/pete-robinson/setmaker-proto/dist"
+)
+
+func TestFormatTimestamp(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatalf("could not load location: %s", err)
+	}
+
+	tests := []struct {
+		name string
+		in   time.Time
+		want string
+	}{
+		{"whole second", time.Date(2024, 5, 1, 9, 30, 0, 0, time.UTC), "2024-05-01T09:30:00.000000000Z"},
+		{"trailing zero nanoseconds", time.Date(2024, 5, 1, 9, 30, 0, 500000000, time.UTC), "2024-05-01T09:30:00.500000000Z"},
+		{"every nanosecond", time.Date(2024, 5, 1, 9, 30, 0, 123456789, time.UTC), "2024-05-01T09:30:00.123456789Z"},
+		{"other zone", time.Date(2024, 5, 1, 10, 30, 0, 0, london), "2024-05-01T09:30:00.000000000Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FormatTimestamp(tt.in)
+			if got != tt.want {
+				t.Errorf("FormatTimestamp() = %q, want %q", got, tt.want)
+			}
+			if len(got) != len(tests[0].want) {
+				t.Errorf("FormatTimestamp() width = %d, want %d", len(got), len(tests[0].want))
+			}
+		})
+	}
+}
+
+
+func TestFormatTimestampSortsInTimeOrder(t *testing.T) {
+	base := time.Date(2024, 5, 1, 9, 30, 0, 0, time.UTC)
+	times := []time.Time{
+		base,
+		base.Add(time.Nanosecond),
+		base.Add(100 * time.Millisecond),
+		base.Add(time.Second),
+		base.Add(10 * time.Second),
+	}
+
+	for i := 1; i < len(times); i++ {
+		earlier, later := FormatTimestamp(times[i-1]), FormatTimestamp(times[i])
+		if earlier >= later {
+			t.Errorf("%q should sort before %q", earlier, later)
+		}
+	}
+}
+
+
+func TestParseTimestamp(t *testing.T) {
+	want := time.Date(2024, 5, 1, 9, 30, 0, 500000000, time.UTC)
+
+	tests := []struct {
+		name    string
+		in      string
+		wantErr bool
+	}{
+		{"current layout", "2024-05-01T09:30:00.500000000Z", false},
+		{"rfc3339 with offset", "2024-05-01T10:30:00.5+01:00", false},
+		{"legacy", "2024-05-01 09:30:00.5 +0000 UTC", false},
+		{"legacy with monotonic reading", "2024-05-01 10:30:00.5 +0100 BST m=+12.345678901", false},
+		{"legacy with negative monotonic reading", "2024-05-01 09:30:00.5 +0000 UTC m=-0.000000001", false},
+		{"empty", "", true},
+		{"not a timestamp", "yesterday", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTimestamp(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseTimestamp(%q) = %v, want an error", tt.in, got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseTimestamp(%q) returned error: %s", tt.in, err)
+			}
+			if !got.Equal(want) {
+				t.Errorf("ParseTimestamp(%q) = %v, want %v", tt.in, got, want)
+			}
+		})
+	}
+}
+
+
+func TestNormaliseTimestampIsIdempotent(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"empty", "", ""},
+		{"current layout", "2024-05-01T09:30:00.500000000Z", "2024-05-01T09:30:00.500000000Z"},
+		{"rfc3339", "2024-05-01T10:30:00.5+01:00", "2024-05-01T09:30:00.500000000Z"},
+		{"legacy with monotonic reading", "2024-05-01 10:30:00.5 +0100 BST m=+12.345678901", "2024-05-01T09:30:00.500000000Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			once, err := NormaliseTimestamp(tt.in)
+			if err != nil {
+				t.Fatalf("NormaliseTimestamp(%q) returned error: %s", tt.in, err)
+			}
+			if once != tt.want {
+				t.Errorf("NormaliseTimestamp(%q) = %q, want %q", tt.in, once, tt.want)
+			}
+
+			twice, err := NormaliseTimestamp(once)
+			if err != nil {
+				t.Fatalf("NormaliseTimestamp(%q) returned error: %s", once, err)
+			}
+			if twice != once {
+				t.Errorf("normalising again changed %q to %q", once, twice)
+			}
+		})
+	}
+
+	if _, err := NormaliseTimestamp("yesterday"); err == nil {
+		t.Error("NormaliseTimestamp accepted a value that isn't a timestamp")
+	}
+}
+
+
+func TestTrashExpiry(t *testing.T) {
+	tests := []struct {
+		name      string
+		deletedAt string
+		want      int64
+		wantErr   bool
+	}{
+		{"live", "", 0, false},
+		{"deleted", "2024-05-01T09:30:00.000000000Z", time.Date(2024, 5, 2, 9, 30, 0, 0, time.UTC).Unix(), false},
+		{"legacy deleted", "2024-05-01 10:30:00 +0100 BST m=+1.5", time.Date(2024, 5, 2, 9, 30, 0, 0, time.UTC).Unix(), false},
+		{"invalid", "yesterday", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TrashExpiry(&setmakerpb.Metadata{DeletedAt: tt.deletedAt}, 24*time.Hour)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TrashExpiry() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("TrashExpiry() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
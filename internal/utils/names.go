@@ -0,0 +1,12 @@
+package utils
+
+import (
+	"strings"
+)
+
+
+// Normalise a name for case-insensitive comparison
+// "  The  Beatles " and "the beatles" both normalise to "the beatles"
+func NormaliseName(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), " ")
+}
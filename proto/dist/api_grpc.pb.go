@@ -0,0 +1,1114 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.9
+// source: src/api.proto
+
+package dist
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// SetMakerServiceClient is the client API for SetMakerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SetMakerServiceClient interface {
+	// artists
+	GetArtist(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*Artist, error)
+	GetArtistByName(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*Artist, error)
+	BatchGetArtists(ctx context.Context, in *BatchGetRequest, opts ...grpc.CallOption) (*BatchGetArtistsResponse, error)
+	CreateArtist(ctx context.Context, in *CreateArtistRequest, opts ...grpc.CallOption) (*Artist, error)
+	UpdateArtist(ctx context.Context, in *UpdateArtistRequest, opts ...grpc.CallOption) (*Artist, error)
+	DeleteArtist(ctx context.Context, in *DeleteArtistRequest, opts ...grpc.CallOption) (*DeleteArtistResponse, error)
+	RestoreArtist(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*RestoreArtistResponse, error)
+	ListArtists(ctx context.Context, in *ListArtistsRequest, opts ...grpc.CallOption) (*ListArtistsResponse, error)
+	// songs
+	GetSong(ctx context.Context, in *GetSongRequest, opts ...grpc.CallOption) (*Song, error)
+	BatchGetSongs(ctx context.Context, in *BatchGetRequest, opts ...grpc.CallOption) (*BatchGetSongsResponse, error)
+	CreateSong(ctx context.Context, in *CreateSongRequest, opts ...grpc.CallOption) (*Song, error)
+	UpdateSong(ctx context.Context, in *UpdateSongRequest, opts ...grpc.CallOption) (*Song, error)
+	DeleteSong(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*DeleteSongResponse, error)
+	RestoreSong(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*Song, error)
+	ListSongs(ctx context.Context, in *ListSongsRequest, opts ...grpc.CallOption) (*ListSongsResponse, error)
+	ListSongsByArtist(ctx context.Context, in *ListSongsByArtistRequest, opts ...grpc.CallOption) (*ListSongsResponse, error)
+	FindCompatibleSongs(ctx context.Context, in *FindCompatibleSongsRequest, opts ...grpc.CallOption) (*FindCompatibleSongsResponse, error)
+	OrderSongs(ctx context.Context, in *OrderSongsRequest, opts ...grpc.CallOption) (*OrderSongsResponse, error)
+	// search
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// setlists
+	GetSetlist(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*Setlist, error)
+	CreateSetlist(ctx context.Context, in *CreateSetlistRequest, opts ...grpc.CallOption) (*Setlist, error)
+	UpdateSetlist(ctx context.Context, in *UpdateSetlistRequest, opts ...grpc.CallOption) (*Setlist, error)
+	DeleteSetlist(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*DeleteSetlistResponse, error)
+	ListSetlists(ctx context.Context, in *ListSetlistsRequest, opts ...grpc.CallOption) (*ListSetlistsResponse, error)
+	// catalog
+	ImportCatalog(ctx context.Context, opts ...grpc.CallOption) (SetMakerService_ImportCatalogClient, error)
+	ExportCatalog(ctx context.Context, in *ExportCatalogRequest, opts ...grpc.CallOption) (SetMakerService_ExportCatalogClient, error)
+	ListDeleted(ctx context.Context, in *ListDeletedRequest, opts ...grpc.CallOption) (*ListDeletedResponse, error)
+}
+
+type setMakerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSetMakerServiceClient(cc grpc.ClientConnInterface) SetMakerServiceClient {
+	return &setMakerServiceClient{cc}
+}
+
+func (c *setMakerServiceClient) GetArtist(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*Artist, error) {
+	out := new(Artist)
+	err := c.cc.Invoke(ctx, "/api.SetMakerService/GetArtist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setMakerServiceClient) GetArtistByName(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*Artist, error) {
+	out := new(Artist)
+	err := c.cc.Invoke(ctx, "/api.SetMakerService/GetArtistByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setMakerServiceClient) BatchGetArtists(ctx context.Context, in *BatchGetRequest, opts ...grpc.CallOption) (*BatchGetArtistsResponse, error) {
+	out := new(BatchGetArtistsResponse)
+	err := c.cc.Invoke(ctx, "/api.SetMakerService/BatchGetArtists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setMakerServiceClient) CreateArtist(ctx context.Context, in *CreateArtistRequest, opts ...grpc.CallOption) (*Artist, error) {
+	out := new(Artist)
+	err := c.cc.Invoke(ctx, "/api.SetMakerService/CreateArtist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setMakerServiceClient) UpdateArtist(ctx context.Context, in *UpdateArtistRequest, opts ...grpc.CallOption) (*Artist, error) {
+	out := new(Artist)
+	err := c.cc.Invoke(ctx, "/api.SetMakerService/UpdateArtist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setMakerServiceClient) DeleteArtist(ctx context.Context, in *DeleteArtistRequest, opts ...grpc.CallOption) (*DeleteArtistResponse, error) {
+	out := new(DeleteArtistResponse)
+	err := c.cc.Invoke(ctx, "/api.SetMakerService/DeleteArtist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setMakerServiceClient) RestoreArtist(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*RestoreArtistResponse, error) {
+	out := new(RestoreArtistResponse)
+	err := c.cc.Invoke(ctx, "/api.SetMakerService/RestoreArtist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setMakerServiceClient) ListArtists(ctx context.Context, in *ListArtistsRequest, opts ...grpc.CallOption) (*ListArtistsResponse, error) {
+	out := new(ListArtistsResponse)
+	err := c.cc.Invoke(ctx, "/api.SetMakerService/ListArtists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setMakerServiceClient) GetSong(ctx context.Context, in *GetSongRequest, opts ...grpc.CallOption) (*Song, error) {
+	out := new(Song)
+	err := c.cc.Invoke(ctx, "/api.SetMakerService/GetSong", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setMakerServiceClient) BatchGetSongs(ctx context.Context, in *BatchGetRequest, opts ...grpc.CallOption) (*BatchGetSongsResponse, error) {
+	out := new(BatchGetSongsResponse)
+	err := c.cc.Invoke(ctx, "/api.SetMakerService/BatchGetSongs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setMakerServiceClient) CreateSong(ctx context.Context, in *CreateSongRequest, opts ...grpc.CallOption) (*Song, error) {
+	out := new(Song)
+	err := c.cc.Invoke(ctx, "/api.SetMakerService/CreateSong", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setMakerServiceClient) UpdateSong(ctx context.Context, in *UpdateSongRequest, opts ...grpc.CallOption) (*Song, error) {
+	out := new(Song)
+	err := c.cc.Invoke(ctx, "/api.SetMakerService/UpdateSong", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setMakerServiceClient) DeleteSong(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*DeleteSongResponse, error) {
+	out := new(DeleteSongResponse)
+	err := c.cc.Invoke(ctx, "/api.SetMakerService/DeleteSong", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setMakerServiceClient) RestoreSong(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*Song, error) {
+	out := new(Song)
+	err := c.cc.Invoke(ctx, "/api.SetMakerService/RestoreSong", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setMakerServiceClient) ListSongs(ctx context.Context, in *ListSongsRequest, opts ...grpc.CallOption) (*ListSongsResponse, error) {
+	out := new(ListSongsResponse)
+	err := c.cc.Invoke(ctx, "/api.SetMakerService/ListSongs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setMakerServiceClient) ListSongsByArtist(ctx context.Context, in *ListSongsByArtistRequest, opts ...grpc.CallOption) (*ListSongsResponse, error) {
+	out := new(ListSongsResponse)
+	err := c.cc.Invoke(ctx, "/api.SetMakerService/ListSongsByArtist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setMakerServiceClient) FindCompatibleSongs(ctx context.Context, in *FindCompatibleSongsRequest, opts ...grpc.CallOption) (*FindCompatibleSongsResponse, error) {
+	out := new(FindCompatibleSongsResponse)
+	err := c.cc.Invoke(ctx, "/api.SetMakerService/FindCompatibleSongs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setMakerServiceClient) OrderSongs(ctx context.Context, in *OrderSongsRequest, opts ...grpc.CallOption) (*OrderSongsResponse, error) {
+	out := new(OrderSongsResponse)
+	err := c.cc.Invoke(ctx, "/api.SetMakerService/OrderSongs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setMakerServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/api.SetMakerService/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setMakerServiceClient) GetSetlist(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*Setlist, error) {
+	out := new(Setlist)
+	err := c.cc.Invoke(ctx, "/api.SetMakerService/GetSetlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setMakerServiceClient) CreateSetlist(ctx context.Context, in *CreateSetlistRequest, opts ...grpc.CallOption) (*Setlist, error) {
+	out := new(Setlist)
+	err := c.cc.Invoke(ctx, "/api.SetMakerService/CreateSetlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setMakerServiceClient) UpdateSetlist(ctx context.Context, in *UpdateSetlistRequest, opts ...grpc.CallOption) (*Setlist, error) {
+	out := new(Setlist)
+	err := c.cc.Invoke(ctx, "/api.SetMakerService/UpdateSetlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setMakerServiceClient) DeleteSetlist(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*DeleteSetlistResponse, error) {
+	out := new(DeleteSetlistResponse)
+	err := c.cc.Invoke(ctx, "/api.SetMakerService/DeleteSetlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setMakerServiceClient) ListSetlists(ctx context.Context, in *ListSetlistsRequest, opts ...grpc.CallOption) (*ListSetlistsResponse, error) {
+	out := new(ListSetlistsResponse)
+	err := c.cc.Invoke(ctx, "/api.SetMakerService/ListSetlists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setMakerServiceClient) ImportCatalog(ctx context.Context, opts ...grpc.CallOption) (SetMakerService_ImportCatalogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SetMakerService_ServiceDesc.Streams[0], "/api.SetMakerService/ImportCatalog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &setMakerServiceImportCatalogClient{stream}
+	return x, nil
+}
+
+type SetMakerService_ImportCatalogClient interface {
+	Send(*ImportCatalogRow) error
+	CloseAndRecv() (*ImportCatalogResponse, error)
+	grpc.ClientStream
+}
+
+type setMakerServiceImportCatalogClient struct {
+	grpc.ClientStream
+}
+
+func (x *setMakerServiceImportCatalogClient) Send(m *ImportCatalogRow) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *setMakerServiceImportCatalogClient) CloseAndRecv() (*ImportCatalogResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportCatalogResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *setMakerServiceClient) ExportCatalog(ctx context.Context, in *ExportCatalogRequest, opts ...grpc.CallOption) (SetMakerService_ExportCatalogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SetMakerService_ServiceDesc.Streams[1], "/api.SetMakerService/ExportCatalog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &setMakerServiceExportCatalogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SetMakerService_ExportCatalogClient interface {
+	Recv() (*ExportCatalogItem, error)
+	grpc.ClientStream
+}
+
+type setMakerServiceExportCatalogClient struct {
+	grpc.ClientStream
+}
+
+func (x *setMakerServiceExportCatalogClient) Recv() (*ExportCatalogItem, error) {
+	m := new(ExportCatalogItem)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *setMakerServiceClient) ListDeleted(ctx context.Context, in *ListDeletedRequest, opts ...grpc.CallOption) (*ListDeletedResponse, error) {
+	out := new(ListDeletedResponse)
+	err := c.cc.Invoke(ctx, "/api.SetMakerService/ListDeleted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SetMakerServiceServer is the server API for SetMakerService service.
+// All implementations must embed UnimplementedSetMakerServiceServer
+// for forward compatibility
+type SetMakerServiceServer interface {
+	// artists
+	GetArtist(context.Context, *wrapperspb.StringValue) (*Artist, error)
+	GetArtistByName(context.Context, *wrapperspb.StringValue) (*Artist, error)
+	BatchGetArtists(context.Context, *BatchGetRequest) (*BatchGetArtistsResponse, error)
+	CreateArtist(context.Context, *CreateArtistRequest) (*Artist, error)
+	UpdateArtist(context.Context, *UpdateArtistRequest) (*Artist, error)
+	DeleteArtist(context.Context, *DeleteArtistRequest) (*DeleteArtistResponse, error)
+	RestoreArtist(context.Context, *wrapperspb.StringValue) (*RestoreArtistResponse, error)
+	ListArtists(context.Context, *ListArtistsRequest) (*ListArtistsResponse, error)
+	// songs
+	GetSong(context.Context, *GetSongRequest) (*Song, error)
+	BatchGetSongs(context.Context, *BatchGetRequest) (*BatchGetSongsResponse, error)
+	CreateSong(context.Context, *CreateSongRequest) (*Song, error)
+	UpdateSong(context.Context, *UpdateSongRequest) (*Song, error)
+	DeleteSong(context.Context, *wrapperspb.StringValue) (*DeleteSongResponse, error)
+	RestoreSong(context.Context, *wrapperspb.StringValue) (*Song, error)
+	ListSongs(context.Context, *ListSongsRequest) (*ListSongsResponse, error)
+	ListSongsByArtist(context.Context, *ListSongsByArtistRequest) (*ListSongsResponse, error)
+	FindCompatibleSongs(context.Context, *FindCompatibleSongsRequest) (*FindCompatibleSongsResponse, error)
+	OrderSongs(context.Context, *OrderSongsRequest) (*OrderSongsResponse, error)
+	// search
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	// setlists
+	GetSetlist(context.Context, *wrapperspb.StringValue) (*Setlist, error)
+	CreateSetlist(context.Context, *CreateSetlistRequest) (*Setlist, error)
+	UpdateSetlist(context.Context, *UpdateSetlistRequest) (*Setlist, error)
+	DeleteSetlist(context.Context, *wrapperspb.StringValue) (*DeleteSetlistResponse, error)
+	ListSetlists(context.Context, *ListSetlistsRequest) (*ListSetlistsResponse, error)
+	// catalog
+	ImportCatalog(SetMakerService_ImportCatalogServer) error
+	ExportCatalog(*ExportCatalogRequest, SetMakerService_ExportCatalogServer) error
+	ListDeleted(context.Context, *ListDeletedRequest) (*ListDeletedResponse, error)
+	mustEmbedUnimplementedSetMakerServiceServer()
+}
+
+// UnimplementedSetMakerServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSetMakerServiceServer struct {
+}
+
+func (UnimplementedSetMakerServiceServer) GetArtist(context.Context, *wrapperspb.StringValue) (*Artist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArtist not implemented")
+}
+func (UnimplementedSetMakerServiceServer) GetArtistByName(context.Context, *wrapperspb.StringValue) (*Artist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArtistByName not implemented")
+}
+func (UnimplementedSetMakerServiceServer) BatchGetArtists(context.Context, *BatchGetRequest) (*BatchGetArtistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetArtists not implemented")
+}
+func (UnimplementedSetMakerServiceServer) CreateArtist(context.Context, *CreateArtistRequest) (*Artist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateArtist not implemented")
+}
+func (UnimplementedSetMakerServiceServer) UpdateArtist(context.Context, *UpdateArtistRequest) (*Artist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateArtist not implemented")
+}
+func (UnimplementedSetMakerServiceServer) DeleteArtist(context.Context, *DeleteArtistRequest) (*DeleteArtistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteArtist not implemented")
+}
+func (UnimplementedSetMakerServiceServer) RestoreArtist(context.Context, *wrapperspb.StringValue) (*RestoreArtistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreArtist not implemented")
+}
+func (UnimplementedSetMakerServiceServer) ListArtists(context.Context, *ListArtistsRequest) (*ListArtistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArtists not implemented")
+}
+func (UnimplementedSetMakerServiceServer) GetSong(context.Context, *GetSongRequest) (*Song, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSong not implemented")
+}
+func (UnimplementedSetMakerServiceServer) BatchGetSongs(context.Context, *BatchGetRequest) (*BatchGetSongsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetSongs not implemented")
+}
+func (UnimplementedSetMakerServiceServer) CreateSong(context.Context, *CreateSongRequest) (*Song, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSong not implemented")
+}
+func (UnimplementedSetMakerServiceServer) UpdateSong(context.Context, *UpdateSongRequest) (*Song, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSong not implemented")
+}
+func (UnimplementedSetMakerServiceServer) DeleteSong(context.Context, *wrapperspb.StringValue) (*DeleteSongResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSong not implemented")
+}
+func (UnimplementedSetMakerServiceServer) RestoreSong(context.Context, *wrapperspb.StringValue) (*Song, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSong not implemented")
+}
+func (UnimplementedSetMakerServiceServer) ListSongs(context.Context, *ListSongsRequest) (*ListSongsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSongs not implemented")
+}
+func (UnimplementedSetMakerServiceServer) ListSongsByArtist(context.Context, *ListSongsByArtistRequest) (*ListSongsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSongsByArtist not implemented")
+}
+func (UnimplementedSetMakerServiceServer) FindCompatibleSongs(context.Context, *FindCompatibleSongsRequest) (*FindCompatibleSongsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindCompatibleSongs not implemented")
+}
+func (UnimplementedSetMakerServiceServer) OrderSongs(context.Context, *OrderSongsRequest) (*OrderSongsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderSongs not implemented")
+}
+func (UnimplementedSetMakerServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedSetMakerServiceServer) GetSetlist(context.Context, *wrapperspb.StringValue) (*Setlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSetlist not implemented")
+}
+func (UnimplementedSetMakerServiceServer) CreateSetlist(context.Context, *CreateSetlistRequest) (*Setlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSetlist not implemented")
+}
+func (UnimplementedSetMakerServiceServer) UpdateSetlist(context.Context, *UpdateSetlistRequest) (*Setlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSetlist not implemented")
+}
+func (UnimplementedSetMakerServiceServer) DeleteSetlist(context.Context, *wrapperspb.StringValue) (*DeleteSetlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSetlist not implemented")
+}
+func (UnimplementedSetMakerServiceServer) ListSetlists(context.Context, *ListSetlistsRequest) (*ListSetlistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSetlists not implemented")
+}
+func (UnimplementedSetMakerServiceServer) ImportCatalog(SetMakerService_ImportCatalogServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportCatalog not implemented")
+}
+func (UnimplementedSetMakerServiceServer) ExportCatalog(*ExportCatalogRequest, SetMakerService_ExportCatalogServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportCatalog not implemented")
+}
+func (UnimplementedSetMakerServiceServer) ListDeleted(context.Context, *ListDeletedRequest) (*ListDeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeleted not implemented")
+}
+func (UnimplementedSetMakerServiceServer) mustEmbedUnimplementedSetMakerServiceServer() {}
+
+// UnsafeSetMakerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SetMakerServiceServer will
+// result in compilation errors.
+type UnsafeSetMakerServiceServer interface {
+	mustEmbedUnimplementedSetMakerServiceServer()
+}
+
+func RegisterSetMakerServiceServer(s grpc.ServiceRegistrar, srv SetMakerServiceServer) {
+	s.RegisterService(&SetMakerService_ServiceDesc, srv)
+}
+
+func _SetMakerService_GetArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetMakerServiceServer).GetArtist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SetMakerService/GetArtist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetMakerServiceServer).GetArtist(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SetMakerService_GetArtistByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetMakerServiceServer).GetArtistByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SetMakerService/GetArtistByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetMakerServiceServer).GetArtistByName(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SetMakerService_BatchGetArtists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetMakerServiceServer).BatchGetArtists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SetMakerService/BatchGetArtists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetMakerServiceServer).BatchGetArtists(ctx, req.(*BatchGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SetMakerService_CreateArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateArtistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetMakerServiceServer).CreateArtist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SetMakerService/CreateArtist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetMakerServiceServer).CreateArtist(ctx, req.(*CreateArtistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SetMakerService_UpdateArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateArtistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetMakerServiceServer).UpdateArtist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SetMakerService/UpdateArtist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetMakerServiceServer).UpdateArtist(ctx, req.(*UpdateArtistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SetMakerService_DeleteArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteArtistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetMakerServiceServer).DeleteArtist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SetMakerService/DeleteArtist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetMakerServiceServer).DeleteArtist(ctx, req.(*DeleteArtistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SetMakerService_RestoreArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetMakerServiceServer).RestoreArtist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SetMakerService/RestoreArtist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetMakerServiceServer).RestoreArtist(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SetMakerService_ListArtists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArtistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetMakerServiceServer).ListArtists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SetMakerService/ListArtists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetMakerServiceServer).ListArtists(ctx, req.(*ListArtistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SetMakerService_GetSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSongRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetMakerServiceServer).GetSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SetMakerService/GetSong",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetMakerServiceServer).GetSong(ctx, req.(*GetSongRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SetMakerService_BatchGetSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetMakerServiceServer).BatchGetSongs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SetMakerService/BatchGetSongs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetMakerServiceServer).BatchGetSongs(ctx, req.(*BatchGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SetMakerService_CreateSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSongRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetMakerServiceServer).CreateSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SetMakerService/CreateSong",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetMakerServiceServer).CreateSong(ctx, req.(*CreateSongRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SetMakerService_UpdateSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSongRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetMakerServiceServer).UpdateSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SetMakerService/UpdateSong",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetMakerServiceServer).UpdateSong(ctx, req.(*UpdateSongRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SetMakerService_DeleteSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetMakerServiceServer).DeleteSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SetMakerService/DeleteSong",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetMakerServiceServer).DeleteSong(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SetMakerService_RestoreSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetMakerServiceServer).RestoreSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SetMakerService/RestoreSong",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetMakerServiceServer).RestoreSong(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SetMakerService_ListSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSongsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetMakerServiceServer).ListSongs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SetMakerService/ListSongs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetMakerServiceServer).ListSongs(ctx, req.(*ListSongsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SetMakerService_ListSongsByArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSongsByArtistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetMakerServiceServer).ListSongsByArtist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SetMakerService/ListSongsByArtist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetMakerServiceServer).ListSongsByArtist(ctx, req.(*ListSongsByArtistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SetMakerService_FindCompatibleSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindCompatibleSongsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetMakerServiceServer).FindCompatibleSongs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SetMakerService/FindCompatibleSongs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetMakerServiceServer).FindCompatibleSongs(ctx, req.(*FindCompatibleSongsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SetMakerService_OrderSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderSongsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetMakerServiceServer).OrderSongs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SetMakerService/OrderSongs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetMakerServiceServer).OrderSongs(ctx, req.(*OrderSongsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SetMakerService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetMakerServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SetMakerService/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetMakerServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SetMakerService_GetSetlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetMakerServiceServer).GetSetlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SetMakerService/GetSetlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetMakerServiceServer).GetSetlist(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SetMakerService_CreateSetlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSetlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetMakerServiceServer).CreateSetlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SetMakerService/CreateSetlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetMakerServiceServer).CreateSetlist(ctx, req.(*CreateSetlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SetMakerService_UpdateSetlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSetlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetMakerServiceServer).UpdateSetlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SetMakerService/UpdateSetlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetMakerServiceServer).UpdateSetlist(ctx, req.(*UpdateSetlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SetMakerService_DeleteSetlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetMakerServiceServer).DeleteSetlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SetMakerService/DeleteSetlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetMakerServiceServer).DeleteSetlist(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SetMakerService_ListSetlists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSetlistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetMakerServiceServer).ListSetlists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SetMakerService/ListSetlists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetMakerServiceServer).ListSetlists(ctx, req.(*ListSetlistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SetMakerService_ImportCatalog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SetMakerServiceServer).ImportCatalog(&setMakerServiceImportCatalogServer{stream})
+}
+
+type SetMakerService_ImportCatalogServer interface {
+	SendAndClose(*ImportCatalogResponse) error
+	Recv() (*ImportCatalogRow, error)
+	grpc.ServerStream
+}
+
+type setMakerServiceImportCatalogServer struct {
+	grpc.ServerStream
+}
+
+func (x *setMakerServiceImportCatalogServer) SendAndClose(m *ImportCatalogResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *setMakerServiceImportCatalogServer) Recv() (*ImportCatalogRow, error) {
+	m := new(ImportCatalogRow)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _SetMakerService_ExportCatalog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportCatalogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SetMakerServiceServer).ExportCatalog(m, &setMakerServiceExportCatalogServer{stream})
+}
+
+type SetMakerService_ExportCatalogServer interface {
+	Send(*ExportCatalogItem) error
+	grpc.ServerStream
+}
+
+type setMakerServiceExportCatalogServer struct {
+	grpc.ServerStream
+}
+
+func (x *setMakerServiceExportCatalogServer) Send(m *ExportCatalogItem) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SetMakerService_ListDeleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetMakerServiceServer).ListDeleted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SetMakerService/ListDeleted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetMakerServiceServer).ListDeleted(ctx, req.(*ListDeletedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SetMakerService_ServiceDesc is the grpc.ServiceDesc for SetMakerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SetMakerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.SetMakerService",
+	HandlerType: (*SetMakerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetArtist",
+			Handler:    _SetMakerService_GetArtist_Handler,
+		},
+		{
+			MethodName: "GetArtistByName",
+			Handler:    _SetMakerService_GetArtistByName_Handler,
+		},
+		{
+			MethodName: "BatchGetArtists",
+			Handler:    _SetMakerService_BatchGetArtists_Handler,
+		},
+		{
+			MethodName: "CreateArtist",
+			Handler:    _SetMakerService_CreateArtist_Handler,
+		},
+		{
+			MethodName: "UpdateArtist",
+			Handler:    _SetMakerService_UpdateArtist_Handler,
+		},
+		{
+			MethodName: "DeleteArtist",
+			Handler:    _SetMakerService_DeleteArtist_Handler,
+		},
+		{
+			MethodName: "RestoreArtist",
+			Handler:    _SetMakerService_RestoreArtist_Handler,
+		},
+		{
+			MethodName: "ListArtists",
+			Handler:    _SetMakerService_ListArtists_Handler,
+		},
+		{
+			MethodName: "GetSong",
+			Handler:    _SetMakerService_GetSong_Handler,
+		},
+		{
+			MethodName: "BatchGetSongs",
+			Handler:    _SetMakerService_BatchGetSongs_Handler,
+		},
+		{
+			MethodName: "CreateSong",
+			Handler:    _SetMakerService_CreateSong_Handler,
+		},
+		{
+			MethodName: "UpdateSong",
+			Handler:    _SetMakerService_UpdateSong_Handler,
+		},
+		{
+			MethodName: "DeleteSong",
+			Handler:    _SetMakerService_DeleteSong_Handler,
+		},
+		{
+			MethodName: "RestoreSong",
+			Handler:    _SetMakerService_RestoreSong_Handler,
+		},
+		{
+			MethodName: "ListSongs",
+			Handler:    _SetMakerService_ListSongs_Handler,
+		},
+		{
+			MethodName: "ListSongsByArtist",
+			Handler:    _SetMakerService_ListSongsByArtist_Handler,
+		},
+		{
+			MethodName: "FindCompatibleSongs",
+			Handler:    _SetMakerService_FindCompatibleSongs_Handler,
+		},
+		{
+			MethodName: "OrderSongs",
+			Handler:    _SetMakerService_OrderSongs_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _SetMakerService_Search_Handler,
+		},
+		{
+			MethodName: "GetSetlist",
+			Handler:    _SetMakerService_GetSetlist_Handler,
+		},
+		{
+			MethodName: "CreateSetlist",
+			Handler:    _SetMakerService_CreateSetlist_Handler,
+		},
+		{
+			MethodName: "UpdateSetlist",
+			Handler:    _SetMakerService_UpdateSetlist_Handler,
+		},
+		{
+			MethodName: "DeleteSetlist",
+			Handler:    _SetMakerService_DeleteSetlist_Handler,
+		},
+		{
+			MethodName: "ListSetlists",
+			Handler:    _SetMakerService_ListSetlists_Handler,
+		},
+		{
+			MethodName: "ListDeleted",
+			Handler:    _SetMakerService_ListDeleted_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportCatalog",
+			Handler:       _SetMakerService_ImportCatalog_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportCatalog",
+			Handler:       _SetMakerService_ExportCatalog_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "src/api.proto",
+}
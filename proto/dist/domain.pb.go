@@ -0,0 +1,884 @@
+// protoc --go_opt=module=github.com/pete-robinson/setmaker-proto --go_out=. src/domain.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.9
+// source: src/domain.proto
+
+package dist
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Key int32
+
+const (
+	Key_KEY_UNKNOWN Key = 0
+	Key_KEY_A       Key = 1
+	Key_KEY_B_FLAT  Key = 2
+	Key_KEY_B       Key = 3
+	Key_KEY_C       Key = 4
+	Key_KEY_C_SHARP Key = 5
+	Key_KEY_D       Key = 6
+	Key_KEY_D_SHARP Key = 7
+	Key_KEY_E       Key = 8
+	Key_KEY_F       Key = 9
+	Key_KEY_F_SHARP Key = 10
+	Key_KEY_G       Key = 11
+	Key_KEY_G_SHARP Key = 12
+	Key_KEY_MIXED   Key = 13
+)
+
+// Enum value maps for Key.
+var (
+	Key_name = map[int32]string{
+		0:  "KEY_UNKNOWN",
+		1:  "KEY_A",
+		2:  "KEY_B_FLAT",
+		3:  "KEY_B",
+		4:  "KEY_C",
+		5:  "KEY_C_SHARP",
+		6:  "KEY_D",
+		7:  "KEY_D_SHARP",
+		8:  "KEY_E",
+		9:  "KEY_F",
+		10: "KEY_F_SHARP",
+		11: "KEY_G",
+		12: "KEY_G_SHARP",
+		13: "KEY_MIXED",
+	}
+	Key_value = map[string]int32{
+		"KEY_UNKNOWN": 0,
+		"KEY_A":       1,
+		"KEY_B_FLAT":  2,
+		"KEY_B":       3,
+		"KEY_C":       4,
+		"KEY_C_SHARP": 5,
+		"KEY_D":       6,
+		"KEY_D_SHARP": 7,
+		"KEY_E":       8,
+		"KEY_F":       9,
+		"KEY_F_SHARP": 10,
+		"KEY_G":       11,
+		"KEY_G_SHARP": 12,
+		"KEY_MIXED":   13,
+	}
+)
+
+func (x Key) Enum() *Key {
+	p := new(Key)
+	*p = x
+	return p
+}
+
+func (x Key) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Key) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_domain_proto_enumTypes[0].Descriptor()
+}
+
+func (Key) Type() protoreflect.EnumType {
+	return &file_src_domain_proto_enumTypes[0]
+}
+
+func (x Key) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Key.Descriptor instead.
+func (Key) EnumDescriptor() ([]byte, []int) {
+	return file_src_domain_proto_rawDescGZIP(), []int{0}
+}
+
+type Tonality int32
+
+const (
+	Tonality_TONALITY_UNKNOWN Tonality = 0
+	Tonality_TONALITY_MAJOR   Tonality = 1
+	Tonality_TONALITY_MINOR   Tonality = 2
+	Tonality_TONALITY_MIXED   Tonality = 3
+)
+
+// Enum value maps for Tonality.
+var (
+	Tonality_name = map[int32]string{
+		0: "TONALITY_UNKNOWN",
+		1: "TONALITY_MAJOR",
+		2: "TONALITY_MINOR",
+		3: "TONALITY_MIXED",
+	}
+	Tonality_value = map[string]int32{
+		"TONALITY_UNKNOWN": 0,
+		"TONALITY_MAJOR":   1,
+		"TONALITY_MINOR":   2,
+		"TONALITY_MIXED":   3,
+	}
+)
+
+func (x Tonality) Enum() *Tonality {
+	p := new(Tonality)
+	*p = x
+	return p
+}
+
+func (x Tonality) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Tonality) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_domain_proto_enumTypes[1].Descriptor()
+}
+
+func (Tonality) Type() protoreflect.EnumType {
+	return &file_src_domain_proto_enumTypes[1]
+}
+
+func (x Tonality) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Tonality.Descriptor instead.
+func (Tonality) EnumDescriptor() ([]byte, []int) {
+	return file_src_domain_proto_rawDescGZIP(), []int{1}
+}
+
+type Artist struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// artist uuid
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name of the artist
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// artist cover image
+	Image string `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	// array of genres as strings
+	Genres []string `protobuf:"bytes,4,rep,name=genres,proto3" json:"genres,omitempty"`
+	// link to spotify artist page
+	SpotifyUrl string `protobuf:"bytes,5,opt,name=spotifyUrl,proto3" json:"spotifyUrl,omitempty"`
+	// meta
+	Metadata *Metadata `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *Artist) Reset() {
+	*x = Artist{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_domain_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Artist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Artist) ProtoMessage() {}
+
+func (x *Artist) ProtoReflect() protoreflect.Message {
+	mi := &file_src_domain_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Artist.ProtoReflect.Descriptor instead.
+func (*Artist) Descriptor() ([]byte, []int) {
+	return file_src_domain_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Artist) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Artist) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Artist) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *Artist) GetGenres() []string {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *Artist) GetSpotifyUrl() string {
+	if x != nil {
+		return x.SpotifyUrl
+	}
+	return ""
+}
+
+func (x *Artist) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type Song struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// song uuid
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// track title
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// song artist
+	ArtistId string `protobuf:"bytes,3,opt,name=artistId,proto3" json:"artistId,omitempty"`
+	// tonic key of the song
+	Key Key `protobuf:"varint,4,opt,name=key,proto3,enum=api.Key" json:"key,omitempty"`
+	// tonality (major/minor etc)
+	Tonality Tonality `protobuf:"varint,5,opt,name=tonality,proto3,enum=api.Tonality" json:"tonality,omitempty"`
+	// meta
+	Metadata *Metadata `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// artist details, only set when a read asks to expand the artist. never stored
+	Artist *ArtistSummary `protobuf:"bytes,7,opt,name=artist,proto3" json:"artist,omitempty"`
+}
+
+func (x *Song) Reset() {
+	*x = Song{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_domain_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Song) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Song) ProtoMessage() {}
+
+func (x *Song) ProtoReflect() protoreflect.Message {
+	mi := &file_src_domain_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Song.ProtoReflect.Descriptor instead.
+func (*Song) Descriptor() ([]byte, []int) {
+	return file_src_domain_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Song) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Song) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Song) GetArtistId() string {
+	if x != nil {
+		return x.ArtistId
+	}
+	return ""
+}
+
+func (x *Song) GetKey() Key {
+	if x != nil {
+		return x.Key
+	}
+	return Key_KEY_UNKNOWN
+}
+
+func (x *Song) GetTonality() Tonality {
+	if x != nil {
+		return x.Tonality
+	}
+	return Tonality_TONALITY_UNKNOWN
+}
+
+func (x *Song) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Song) GetArtist() *ArtistSummary {
+	if x != nil {
+		return x.Artist
+	}
+	return nil
+}
+
+type ArtistSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// artist uuid
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name of the artist
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// artist cover image
+	Image string `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+}
+
+func (x *ArtistSummary) Reset() {
+	*x = ArtistSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_domain_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtistSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtistSummary) ProtoMessage() {}
+
+func (x *ArtistSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_src_domain_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtistSummary.ProtoReflect.Descriptor instead.
+func (*ArtistSummary) Descriptor() ([]byte, []int) {
+	return file_src_domain_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ArtistSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ArtistSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ArtistSummary) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+type Setlist struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// setlist uuid
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name of the set
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// venue the set is played at
+	Venue string `protobuf:"bytes,3,opt,name=venue,proto3" json:"venue,omitempty"`
+	// date of the performance (YYYY-MM-DD)
+	Date string `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	// ordered list of song slots
+	Slots []*SetlistSlot `protobuf:"bytes,5,rep,name=slots,proto3" json:"slots,omitempty"`
+	// meta
+	Metadata *Metadata `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *Setlist) Reset() {
+	*x = Setlist{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_domain_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Setlist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Setlist) ProtoMessage() {}
+
+func (x *Setlist) ProtoReflect() protoreflect.Message {
+	mi := &file_src_domain_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Setlist.ProtoReflect.Descriptor instead.
+func (*Setlist) Descriptor() ([]byte, []int) {
+	return file_src_domain_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Setlist) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Setlist) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Setlist) GetVenue() string {
+	if x != nil {
+		return x.Venue
+	}
+	return ""
+}
+
+func (x *Setlist) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Setlist) GetSlots() []*SetlistSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *Setlist) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type SetlistSlot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// song uuid
+	SongId string `protobuf:"bytes,1,opt,name=songId,proto3" json:"songId,omitempty"`
+	// performance notes for this slot
+	Notes string `protobuf:"bytes,2,opt,name=notes,proto3" json:"notes,omitempty"`
+}
+
+func (x *SetlistSlot) Reset() {
+	*x = SetlistSlot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_domain_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetlistSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetlistSlot) ProtoMessage() {}
+
+func (x *SetlistSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_src_domain_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetlistSlot.ProtoReflect.Descriptor instead.
+func (*SetlistSlot) Descriptor() ([]byte, []int) {
+	return file_src_domain_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetlistSlot) GetSongId() string {
+	if x != nil {
+		return x.SongId
+	}
+	return ""
+}
+
+func (x *SetlistSlot) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type Metadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// timestamps are RFC 3339 in UTC with nanoseconds, e.g. 2022-11-01T10:00:00.100000000Z
+	CreatedAt string `protobuf:"bytes,1,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt string `protobuf:"bytes,2,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// incremented on every write. used for optimistic concurrency
+	Version int64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// set when the record is deleted. deleted records can be restored until they are purged
+	DeletedAt string `protobuf:"bytes,4,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
+	// subject of the caller who created the record
+	CreatedBy string `protobuf:"bytes,5,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	// subject of the caller who last wrote the record
+	UpdatedBy string `protobuf:"bytes,6,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
+}
+
+func (x *Metadata) Reset() {
+	*x = Metadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_domain_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Metadata) ProtoMessage() {}
+
+func (x *Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_src_domain_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Metadata.ProtoReflect.Descriptor instead.
+func (*Metadata) Descriptor() ([]byte, []int) {
+	return file_src_domain_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Metadata) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Metadata) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Metadata) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Metadata) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Metadata) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Metadata) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+var File_src_domain_proto protoreflect.FileDescriptor
+
+var file_src_domain_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x73, 0x72, 0x63, 0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x03, 0x61, 0x70, 0x69, 0x22, 0xa5, 0x01, 0x0a, 0x06, 0x41, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x65,
+	0x6e, 0x72, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55,
+	0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x55, 0x72, 0x6c, 0x12, 0x29, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0xe6, 0x01, 0x0a, 0x04, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4b, 0x65,
+	0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x08, 0x74, 0x6f, 0x6e, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54,
+	0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x08, 0x74, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x29, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2a, 0x0a, 0x06,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x52, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x0d, 0x41, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a,
+	0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x65, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05,
+	0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x3b, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x6f, 0x6e, 0x67, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x6f, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0xba, 0x01,
+	0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x2a, 0xc6, 0x01, 0x0a, 0x03, 0x4b,
+	0x65, 0x79, 0x12, 0x0f, 0x0a, 0x0b, 0x4b, 0x45, 0x59, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4b, 0x45, 0x59, 0x5f, 0x41, 0x10, 0x01, 0x12, 0x0e,
+	0x0a, 0x0a, 0x4b, 0x45, 0x59, 0x5f, 0x42, 0x5f, 0x46, 0x4c, 0x41, 0x54, 0x10, 0x02, 0x12, 0x09,
+	0x0a, 0x05, 0x4b, 0x45, 0x59, 0x5f, 0x42, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x4b, 0x45, 0x59,
+	0x5f, 0x43, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x4b, 0x45, 0x59, 0x5f, 0x43, 0x5f, 0x53, 0x48,
+	0x41, 0x52, 0x50, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x4b, 0x45, 0x59, 0x5f, 0x44, 0x10, 0x06,
+	0x12, 0x0f, 0x0a, 0x0b, 0x4b, 0x45, 0x59, 0x5f, 0x44, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x50, 0x10,
+	0x07, 0x12, 0x09, 0x0a, 0x05, 0x4b, 0x45, 0x59, 0x5f, 0x45, 0x10, 0x08, 0x12, 0x09, 0x0a, 0x05,
+	0x4b, 0x45, 0x59, 0x5f, 0x46, 0x10, 0x09, 0x12, 0x0f, 0x0a, 0x0b, 0x4b, 0x45, 0x59, 0x5f, 0x46,
+	0x5f, 0x53, 0x48, 0x41, 0x52, 0x50, 0x10, 0x0a, 0x12, 0x09, 0x0a, 0x05, 0x4b, 0x45, 0x59, 0x5f,
+	0x47, 0x10, 0x0b, 0x12, 0x0f, 0x0a, 0x0b, 0x4b, 0x45, 0x59, 0x5f, 0x47, 0x5f, 0x53, 0x48, 0x41,
+	0x52, 0x50, 0x10, 0x0c, 0x12, 0x0d, 0x0a, 0x09, 0x4b, 0x45, 0x59, 0x5f, 0x4d, 0x49, 0x58, 0x45,
+	0x44, 0x10, 0x0d, 0x2a, 0x5c, 0x0a, 0x08, 0x54, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x14, 0x0a, 0x10, 0x54, 0x4f, 0x4e, 0x41, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x4f, 0x4e, 0x41, 0x4c, 0x49, 0x54,
+	0x59, 0x5f, 0x4d, 0x41, 0x4a, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x4f, 0x4e,
+	0x41, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x4d, 0x49, 0x4e, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x12, 0x0a,
+	0x0e, 0x54, 0x4f, 0x4e, 0x41, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x4d, 0x49, 0x58, 0x45, 0x44, 0x10,
+	0x03, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x65, 0x74, 0x65, 0x2d, 0x72, 0x6f, 0x62, 0x69, 0x6e, 0x73, 0x6f, 0x6e, 0x2f, 0x73, 0x65,
+	0x74, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x69, 0x73,
+	0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_src_domain_proto_rawDescOnce sync.Once
+	file_src_domain_proto_rawDescData = file_src_domain_proto_rawDesc
+)
+
+func file_src_domain_proto_rawDescGZIP() []byte {
+	file_src_domain_proto_rawDescOnce.Do(func() {
+		file_src_domain_proto_rawDescData = protoimpl.X.CompressGZIP(file_src_domain_proto_rawDescData)
+	})
+	return file_src_domain_proto_rawDescData
+}
+
+var file_src_domain_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_src_domain_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_src_domain_proto_goTypes = []interface{}{
+	(Key)(0),              // 0: api.Key
+	(Tonality)(0),         // 1: api.Tonality
+	(*Artist)(nil),        // 2: api.Artist
+	(*Song)(nil),          // 3: api.Song
+	(*ArtistSummary)(nil), // 4: api.ArtistSummary
+	(*Setlist)(nil),       // 5: api.Setlist
+	(*SetlistSlot)(nil),   // 6: api.SetlistSlot
+	(*Metadata)(nil),      // 7: api.Metadata
+}
+var file_src_domain_proto_depIdxs = []int32{
+	7, // 0: api.Artist.metadata:type_name -> api.Metadata
+	0, // 1: api.Song.key:type_name -> api.Key
+	1, // 2: api.Song.tonality:type_name -> api.Tonality
+	7, // 3: api.Song.metadata:type_name -> api.Metadata
+	4, // 4: api.Song.artist:type_name -> api.ArtistSummary
+	6, // 5: api.Setlist.slots:type_name -> api.SetlistSlot
+	7, // 6: api.Setlist.metadata:type_name -> api.Metadata
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_src_domain_proto_init() }
+func file_src_domain_proto_init() {
+	if File_src_domain_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_src_domain_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Artist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_domain_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Song); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_domain_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtistSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_domain_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Setlist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_domain_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetlistSlot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_domain_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Metadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_src_domain_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_src_domain_proto_goTypes,
+		DependencyIndexes: file_src_domain_proto_depIdxs,
+		EnumInfos:         file_src_domain_proto_enumTypes,
+		MessageInfos:      file_src_domain_proto_msgTypes,
+	}.Build()
+	File_src_domain_proto = out.File
+	file_src_domain_proto_rawDesc = nil
+	file_src_domain_proto_goTypes = nil
+	file_src_domain_proto_depIdxs = nil
+}
@@ -0,0 +1,1033 @@
+// protoc --go_opt=module=github.com/pete-robinson/setmaker-proto --go_out=. src/events.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.9
+// source: src/events.proto
+
+package dist
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Event_EventType int32
+
+const (
+	Event_EVENT_ARTIST_CREATED  Event_EventType = 0
+	Event_EVENT_ARTIST_DELETED  Event_EventType = 1
+	Event_EVENT_ARTIST_UPDATED  Event_EventType = 2
+	Event_EVENT_SONG_CREATED    Event_EventType = 3
+	Event_EVENT_SONG_UPDATED    Event_EventType = 4
+	Event_EVENT_SONG_DELETED    Event_EventType = 5
+	Event_EVENT_ARTIST_RESTORED Event_EventType = 6
+	Event_EVENT_SONG_RESTORED   Event_EventType = 7
+)
+
+// Enum value maps for Event_EventType.
+var (
+	Event_EventType_name = map[int32]string{
+		0: "EVENT_ARTIST_CREATED",
+		1: "EVENT_ARTIST_DELETED",
+		2: "EVENT_ARTIST_UPDATED",
+		3: "EVENT_SONG_CREATED",
+		4: "EVENT_SONG_UPDATED",
+		5: "EVENT_SONG_DELETED",
+		6: "EVENT_ARTIST_RESTORED",
+		7: "EVENT_SONG_RESTORED",
+	}
+	Event_EventType_value = map[string]int32{
+		"EVENT_ARTIST_CREATED":  0,
+		"EVENT_ARTIST_DELETED":  1,
+		"EVENT_ARTIST_UPDATED":  2,
+		"EVENT_SONG_CREATED":    3,
+		"EVENT_SONG_UPDATED":    4,
+		"EVENT_SONG_DELETED":    5,
+		"EVENT_ARTIST_RESTORED": 6,
+		"EVENT_SONG_RESTORED":   7,
+	}
+)
+
+func (x Event_EventType) Enum() *Event_EventType {
+	p := new(Event_EventType)
+	*p = x
+	return p
+}
+
+func (x Event_EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Event_EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_events_proto_enumTypes[0].Descriptor()
+}
+
+func (Event_EventType) Type() protoreflect.EnumType {
+	return &file_src_events_proto_enumTypes[0]
+}
+
+func (x Event_EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Event_EventType.Descriptor instead.
+func (Event_EventType) EnumDescriptor() ([]byte, []int) {
+	return file_src_events_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventType Event_EventType `protobuf:"varint,1,opt,name=eventType,proto3,enum=api.Event_EventType" json:"eventType,omitempty"` // event type identifier
+	// Types that are assignable to MessageBody:
+	//	*Event_ArtistCreated
+	//	*Event_ArtistDeleted
+	//	*Event_ArtistUpdated
+	//	*Event_SongCreated
+	//	*Event_SongUpdated
+	//	*Event_SongDeleted
+	//	*Event_ArtistRestored
+	//	*Event_SongRestored
+	MessageBody isEvent_MessageBody `protobuf_oneof:"messageBody"`
+	TenantId    string              `protobuf:"bytes,10,opt,name=tenantId,proto3" json:"tenantId,omitempty"` // tenant that owns the records the event is about
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_src_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_src_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Event) GetEventType() Event_EventType {
+	if x != nil {
+		return x.EventType
+	}
+	return Event_EVENT_ARTIST_CREATED
+}
+
+func (m *Event) GetMessageBody() isEvent_MessageBody {
+	if m != nil {
+		return m.MessageBody
+	}
+	return nil
+}
+
+func (x *Event) GetArtistCreated() *MessageBody_ArtistCreated {
+	if x, ok := x.GetMessageBody().(*Event_ArtistCreated); ok {
+		return x.ArtistCreated
+	}
+	return nil
+}
+
+func (x *Event) GetArtistDeleted() *MessageBody_ArtistDeleted {
+	if x, ok := x.GetMessageBody().(*Event_ArtistDeleted); ok {
+		return x.ArtistDeleted
+	}
+	return nil
+}
+
+func (x *Event) GetArtistUpdated() *MessageBody_ArtistUpdated {
+	if x, ok := x.GetMessageBody().(*Event_ArtistUpdated); ok {
+		return x.ArtistUpdated
+	}
+	return nil
+}
+
+func (x *Event) GetSongCreated() *MessageBody_SongCreated {
+	if x, ok := x.GetMessageBody().(*Event_SongCreated); ok {
+		return x.SongCreated
+	}
+	return nil
+}
+
+func (x *Event) GetSongUpdated() *MessageBody_SongUpdated {
+	if x, ok := x.GetMessageBody().(*Event_SongUpdated); ok {
+		return x.SongUpdated
+	}
+	return nil
+}
+
+func (x *Event) GetSongDeleted() *MessageBody_SongDeleted {
+	if x, ok := x.GetMessageBody().(*Event_SongDeleted); ok {
+		return x.SongDeleted
+	}
+	return nil
+}
+
+func (x *Event) GetArtistRestored() *MessageBody_ArtistRestored {
+	if x, ok := x.GetMessageBody().(*Event_ArtistRestored); ok {
+		return x.ArtistRestored
+	}
+	return nil
+}
+
+func (x *Event) GetSongRestored() *MessageBody_SongRestored {
+	if x, ok := x.GetMessageBody().(*Event_SongRestored); ok {
+		return x.SongRestored
+	}
+	return nil
+}
+
+func (x *Event) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type isEvent_MessageBody interface {
+	isEvent_MessageBody()
+}
+
+type Event_ArtistCreated struct {
+	ArtistCreated *MessageBody_ArtistCreated `protobuf:"bytes,2,opt,name=artistCreated,proto3,oneof"`
+}
+
+type Event_ArtistDeleted struct {
+	ArtistDeleted *MessageBody_ArtistDeleted `protobuf:"bytes,3,opt,name=artistDeleted,proto3,oneof"`
+}
+
+type Event_ArtistUpdated struct {
+	ArtistUpdated *MessageBody_ArtistUpdated `protobuf:"bytes,4,opt,name=artistUpdated,proto3,oneof"`
+}
+
+type Event_SongCreated struct {
+	SongCreated *MessageBody_SongCreated `protobuf:"bytes,5,opt,name=songCreated,proto3,oneof"`
+}
+
+type Event_SongUpdated struct {
+	SongUpdated *MessageBody_SongUpdated `protobuf:"bytes,6,opt,name=songUpdated,proto3,oneof"`
+}
+
+type Event_SongDeleted struct {
+	SongDeleted *MessageBody_SongDeleted `protobuf:"bytes,7,opt,name=songDeleted,proto3,oneof"`
+}
+
+type Event_ArtistRestored struct {
+	ArtistRestored *MessageBody_ArtistRestored `protobuf:"bytes,8,opt,name=artistRestored,proto3,oneof"`
+}
+
+type Event_SongRestored struct {
+	SongRestored *MessageBody_SongRestored `protobuf:"bytes,9,opt,name=songRestored,proto3,oneof"`
+}
+
+func (*Event_ArtistCreated) isEvent_MessageBody() {}
+
+func (*Event_ArtistDeleted) isEvent_MessageBody() {}
+
+func (*Event_ArtistUpdated) isEvent_MessageBody() {}
+
+func (*Event_SongCreated) isEvent_MessageBody() {}
+
+func (*Event_SongUpdated) isEvent_MessageBody() {}
+
+func (*Event_SongDeleted) isEvent_MessageBody() {}
+
+func (*Event_ArtistRestored) isEvent_MessageBody() {}
+
+func (*Event_SongRestored) isEvent_MessageBody() {}
+
+type MessageBody_ArtistCreated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *MessageBody_ArtistCreated) Reset() {
+	*x = MessageBody_ArtistCreated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageBody_ArtistCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageBody_ArtistCreated) ProtoMessage() {}
+
+func (x *MessageBody_ArtistCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_src_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageBody_ArtistCreated.ProtoReflect.Descriptor instead.
+func (*MessageBody_ArtistCreated) Descriptor() ([]byte, []int) {
+	return file_src_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MessageBody_ArtistCreated) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MessageBody_ArtistCreated) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type MessageBody_ArtistDeleted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Artist *Artist `protobuf:"bytes,2,opt,name=artist,proto3" json:"artist,omitempty"` // artist as it was before deletion
+}
+
+func (x *MessageBody_ArtistDeleted) Reset() {
+	*x = MessageBody_ArtistDeleted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageBody_ArtistDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageBody_ArtistDeleted) ProtoMessage() {}
+
+func (x *MessageBody_ArtistDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_src_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageBody_ArtistDeleted.ProtoReflect.Descriptor instead.
+func (*MessageBody_ArtistDeleted) Descriptor() ([]byte, []int) {
+	return file_src_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MessageBody_ArtistDeleted) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MessageBody_ArtistDeleted) GetArtist() *Artist {
+	if x != nil {
+		return x.Artist
+	}
+	return nil
+}
+
+type MessageBody_ArtistUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Before *Artist `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"` // artist before the update
+	After  *Artist `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`   // artist after the update
+}
+
+func (x *MessageBody_ArtistUpdated) Reset() {
+	*x = MessageBody_ArtistUpdated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageBody_ArtistUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageBody_ArtistUpdated) ProtoMessage() {}
+
+func (x *MessageBody_ArtistUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_src_events_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageBody_ArtistUpdated.ProtoReflect.Descriptor instead.
+func (*MessageBody_ArtistUpdated) Descriptor() ([]byte, []int) {
+	return file_src_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MessageBody_ArtistUpdated) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MessageBody_ArtistUpdated) GetBefore() *Artist {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *MessageBody_ArtistUpdated) GetAfter() *Artist {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type MessageBody_SongCreated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Song *Song  `protobuf:"bytes,2,opt,name=song,proto3" json:"song,omitempty"`
+}
+
+func (x *MessageBody_SongCreated) Reset() {
+	*x = MessageBody_SongCreated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageBody_SongCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageBody_SongCreated) ProtoMessage() {}
+
+func (x *MessageBody_SongCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_src_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageBody_SongCreated.ProtoReflect.Descriptor instead.
+func (*MessageBody_SongCreated) Descriptor() ([]byte, []int) {
+	return file_src_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MessageBody_SongCreated) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MessageBody_SongCreated) GetSong() *Song {
+	if x != nil {
+		return x.Song
+	}
+	return nil
+}
+
+type MessageBody_SongUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Before *Song  `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"` // song before the update
+	After  *Song  `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`   // song after the update
+}
+
+func (x *MessageBody_SongUpdated) Reset() {
+	*x = MessageBody_SongUpdated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageBody_SongUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageBody_SongUpdated) ProtoMessage() {}
+
+func (x *MessageBody_SongUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_src_events_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageBody_SongUpdated.ProtoReflect.Descriptor instead.
+func (*MessageBody_SongUpdated) Descriptor() ([]byte, []int) {
+	return file_src_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MessageBody_SongUpdated) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MessageBody_SongUpdated) GetBefore() *Song {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *MessageBody_SongUpdated) GetAfter() *Song {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type MessageBody_SongDeleted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Song *Song  `protobuf:"bytes,2,opt,name=song,proto3" json:"song,omitempty"` // song as it was before deletion
+}
+
+func (x *MessageBody_SongDeleted) Reset() {
+	*x = MessageBody_SongDeleted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_events_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageBody_SongDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageBody_SongDeleted) ProtoMessage() {}
+
+func (x *MessageBody_SongDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_src_events_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageBody_SongDeleted.ProtoReflect.Descriptor instead.
+func (*MessageBody_SongDeleted) Descriptor() ([]byte, []int) {
+	return file_src_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MessageBody_SongDeleted) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MessageBody_SongDeleted) GetSong() *Song {
+	if x != nil {
+		return x.Song
+	}
+	return nil
+}
+
+type MessageBody_ArtistRestored struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Artist *Artist `protobuf:"bytes,2,opt,name=artist,proto3" json:"artist,omitempty"` // artist as it was after being restored
+}
+
+func (x *MessageBody_ArtistRestored) Reset() {
+	*x = MessageBody_ArtistRestored{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_events_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageBody_ArtistRestored) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageBody_ArtistRestored) ProtoMessage() {}
+
+func (x *MessageBody_ArtistRestored) ProtoReflect() protoreflect.Message {
+	mi := &file_src_events_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageBody_ArtistRestored.ProtoReflect.Descriptor instead.
+func (*MessageBody_ArtistRestored) Descriptor() ([]byte, []int) {
+	return file_src_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MessageBody_ArtistRestored) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MessageBody_ArtistRestored) GetArtist() *Artist {
+	if x != nil {
+		return x.Artist
+	}
+	return nil
+}
+
+type MessageBody_SongRestored struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Song *Song  `protobuf:"bytes,2,opt,name=song,proto3" json:"song,omitempty"` // song as it was after being restored
+}
+
+func (x *MessageBody_SongRestored) Reset() {
+	*x = MessageBody_SongRestored{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_events_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageBody_SongRestored) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageBody_SongRestored) ProtoMessage() {}
+
+func (x *MessageBody_SongRestored) ProtoReflect() protoreflect.Message {
+	mi := &file_src_events_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageBody_SongRestored.ProtoReflect.Descriptor instead.
+func (*MessageBody_SongRestored) Descriptor() ([]byte, []int) {
+	return file_src_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MessageBody_SongRestored) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MessageBody_SongRestored) GetSong() *Song {
+	if x != nil {
+		return x.Song
+	}
+	return nil
+}
+
+var File_src_events_proto protoreflect.FileDescriptor
+
+var file_src_events_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x73, 0x72, 0x63, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x03, 0x61, 0x70, 0x69, 0x1a, 0x10, 0x73, 0x72, 0x63, 0x2f, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xec, 0x06, 0x0a, 0x05, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x0d, 0x61, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79,
+	0x5f, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x48, 0x00,
+	0x52, 0x0d, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x46, 0x0a, 0x0d, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x5f, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x0d, 0x61, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79,
+	0x5f, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x48, 0x00,
+	0x52, 0x0d, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x40, 0x0a, 0x0b, 0x73, 0x6f, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x5f, 0x53, 0x6f, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x6f, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x40, 0x0a, 0x0b, 0x73, 0x6f, 0x6e, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x5f, 0x53, 0x6f, 0x6e, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x6f, 0x6e, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x0b, 0x73, 0x6f, 0x6e, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x5f, 0x53, 0x6f, 0x6e, 0x67, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x6f, 0x6e, 0x67, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x49, 0x0a, 0x0e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x5f,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x48, 0x00,
+	0x52, 0x0e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64,
+	0x12, 0x43, 0x0a, 0x0c, 0x73, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x5f, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49,
+	0x64, 0x22, 0xd5, 0x01, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x18, 0x0a, 0x14, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x52, 0x54, 0x49, 0x53, 0x54, 0x5f,
+	0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x41, 0x52, 0x54, 0x49, 0x53, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x52, 0x54,
+	0x49, 0x53, 0x54, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x16, 0x0a,
+	0x12, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x4e, 0x47, 0x5f, 0x43, 0x52, 0x45, 0x41,
+	0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53,
+	0x4f, 0x4e, 0x47, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x16, 0x0a,
+	0x12, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x4e, 0x47, 0x5f, 0x44, 0x45, 0x4c, 0x45,
+	0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41,
+	0x52, 0x54, 0x49, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x44, 0x10, 0x06,
+	0x12, 0x17, 0x0a, 0x13, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x4e, 0x47, 0x5f, 0x52,
+	0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x44, 0x10, 0x07, 0x42, 0x0d, 0x0a, 0x0b, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x3f, 0x0a, 0x19, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x5f, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x50, 0x0a, 0x19, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x5f, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x52, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x22, 0x73, 0x0a, 0x19, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x5f, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a,
+	0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x22, 0x48, 0x0a, 0x17, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x5f,
+	0x53, 0x6f, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x04, 0x73,
+	0x6f, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x22, 0x6d, 0x0a, 0x17, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x5f, 0x53, 0x6f, 0x6e, 0x67, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x6e, 0x67,
+	0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f,
+	0x6e, 0x67, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x48, 0x0a, 0x17, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x5f, 0x53, 0x6f, 0x6e, 0x67, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73,
+	0x6f, 0x6e, 0x67, 0x22, 0x51, 0x0a, 0x1a, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x6f,
+	0x64, 0x79, 0x5f, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x23, 0x0a, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x06,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x18, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x42, 0x6f, 0x64, 0x79, 0x5f, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1d, 0x0a, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x6e,
+	0x67, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x65, 0x74, 0x65, 0x2d, 0x72, 0x6f, 0x62, 0x69, 0x6e, 0x73, 0x6f, 0x6e, 0x2f, 0x73, 0x65,
+	0x74, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x69, 0x73,
+	0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_src_events_proto_rawDescOnce sync.Once
+	file_src_events_proto_rawDescData = file_src_events_proto_rawDesc
+)
+
+func file_src_events_proto_rawDescGZIP() []byte {
+	file_src_events_proto_rawDescOnce.Do(func() {
+		file_src_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_src_events_proto_rawDescData)
+	})
+	return file_src_events_proto_rawDescData
+}
+
+var file_src_events_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_src_events_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_src_events_proto_goTypes = []interface{}{
+	(Event_EventType)(0),               // 0: api.Event.EventType
+	(*Event)(nil),                      // 1: api.Event
+	(*MessageBody_ArtistCreated)(nil),  // 2: api.MessageBody_ArtistCreated
+	(*MessageBody_ArtistDeleted)(nil),  // 3: api.MessageBody_ArtistDeleted
+	(*MessageBody_ArtistUpdated)(nil),  // 4: api.MessageBody_ArtistUpdated
+	(*MessageBody_SongCreated)(nil),    // 5: api.MessageBody_SongCreated
+	(*MessageBody_SongUpdated)(nil),    // 6: api.MessageBody_SongUpdated
+	(*MessageBody_SongDeleted)(nil),    // 7: api.MessageBody_SongDeleted
+	(*MessageBody_ArtistRestored)(nil), // 8: api.MessageBody_ArtistRestored
+	(*MessageBody_SongRestored)(nil),   // 9: api.MessageBody_SongRestored
+	(*Artist)(nil),                     // 10: api.Artist
+	(*Song)(nil),                       // 11: api.Song
+}
+var file_src_events_proto_depIdxs = []int32{
+	0,  // 0: api.Event.eventType:type_name -> api.Event.EventType
+	2,  // 1: api.Event.artistCreated:type_name -> api.MessageBody_ArtistCreated
+	3,  // 2: api.Event.artistDeleted:type_name -> api.MessageBody_ArtistDeleted
+	4,  // 3: api.Event.artistUpdated:type_name -> api.MessageBody_ArtistUpdated
+	5,  // 4: api.Event.songCreated:type_name -> api.MessageBody_SongCreated
+	6,  // 5: api.Event.songUpdated:type_name -> api.MessageBody_SongUpdated
+	7,  // 6: api.Event.songDeleted:type_name -> api.MessageBody_SongDeleted
+	8,  // 7: api.Event.artistRestored:type_name -> api.MessageBody_ArtistRestored
+	9,  // 8: api.Event.songRestored:type_name -> api.MessageBody_SongRestored
+	10, // 9: api.MessageBody_ArtistDeleted.artist:type_name -> api.Artist
+	10, // 10: api.MessageBody_ArtistUpdated.before:type_name -> api.Artist
+	10, // 11: api.MessageBody_ArtistUpdated.after:type_name -> api.Artist
+	11, // 12: api.MessageBody_SongCreated.song:type_name -> api.Song
+	11, // 13: api.MessageBody_SongUpdated.before:type_name -> api.Song
+	11, // 14: api.MessageBody_SongUpdated.after:type_name -> api.Song
+	11, // 15: api.MessageBody_SongDeleted.song:type_name -> api.Song
+	10, // 16: api.MessageBody_ArtistRestored.artist:type_name -> api.Artist
+	11, // 17: api.MessageBody_SongRestored.song:type_name -> api.Song
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_src_events_proto_init() }
+func file_src_events_proto_init() {
+	if File_src_events_proto != nil {
+		return
+	}
+	file_src_domain_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_src_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageBody_ArtistCreated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageBody_ArtistDeleted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageBody_ArtistUpdated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageBody_SongCreated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageBody_SongUpdated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_events_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageBody_SongDeleted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_events_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageBody_ArtistRestored); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_events_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageBody_SongRestored); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_src_events_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*Event_ArtistCreated)(nil),
+		(*Event_ArtistDeleted)(nil),
+		(*Event_ArtistUpdated)(nil),
+		(*Event_SongCreated)(nil),
+		(*Event_SongUpdated)(nil),
+		(*Event_SongDeleted)(nil),
+		(*Event_ArtistRestored)(nil),
+		(*Event_SongRestored)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_src_events_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_src_events_proto_goTypes,
+		DependencyIndexes: file_src_events_proto_depIdxs,
+		EnumInfos:         file_src_events_proto_enumTypes,
+		MessageInfos:      file_src_events_proto_msgTypes,
+	}.Build()
+	File_src_events_proto = out.File
+	file_src_events_proto_rawDesc = nil
+	file_src_events_proto_goTypes = nil
+	file_src_events_proto_depIdxs = nil
+}